@@ -33,7 +33,8 @@ func dataSourceSumologicAdminRecommendedFolder() *schema.Resource {
 }
 
 func dataSourceSumologicAdminRecommendedFolderRead(d *schema.ResourceData, meta interface{}) error {
-	c := meta.(*Client)
+	c, cancel := meta.(*Client).withTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
 
 	adminRecommendedFolder, err := c.getAdminRecommendedFolder(d.Timeout(schema.TimeoutRead))
 
@@ -0,0 +1,217 @@
+package sumologic
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceSumologicCollectors() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSumologicCollectorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"collector_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Hosted", "Installable"}, false),
+			},
+			"alive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"category_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"fields": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"collectors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"collector_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fields": {
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+						"alive": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"last_seen_alive": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"host_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"collector_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSumologicCollectorsRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	// The type is filtered by the API, the other filters are applied here.
+	var apiFilter string
+	switch d.Get("collector_type").(string) {
+	case "Hosted":
+		apiFilter = "hosted"
+	case "Installable":
+		apiFilter = "installed"
+	}
+	collectors, err := c.ListCollectors(apiFilter)
+	if err != nil {
+		return err
+	}
+
+	matcher, err := newListMatcher(d)
+	if err != nil {
+		return err
+	}
+	alive, filterAlive := d.GetOkExists("alive")
+
+	var ids []int64
+	var matches []map[string]interface{}
+	for _, collector := range collectors {
+		if !matcher.matches(collector.Name, collector.Category, collector.Fields) {
+			continue
+		}
+		if filterAlive && collector.Alive != alive.(bool) {
+			continue
+		}
+		ids = append(ids, collector.ID)
+		matches = append(matches, map[string]interface{}{
+			"id":                collector.ID,
+			"name":              collector.Name,
+			"description":       collector.Description,
+			"category":          collector.Category,
+			"collector_type":    collector.CollectorType,
+			"timezone":          collector.TimeZone,
+			"fields":            collector.Fields,
+			"alive":             collector.Alive,
+			"last_seen_alive":   collector.LastSeenAlive,
+			"host_name":         collector.HostName,
+			"collector_version": collector.CollectorVersion,
+		})
+	}
+
+	d.SetId(listID(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids for datasource %s: %s", d.Id(), err)
+	}
+	if err := d.Set("collectors", matches); err != nil {
+		return fmt.Errorf("error setting collectors for datasource %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] data_source_sumologic_collectors: %d of %d collectors match", len(ids), len(collectors))
+	return nil
+}
+
+// listMatcher holds the name_regex, category_prefix and fields filters shared
+// by the collectors and sources data sources.
+type listMatcher struct {
+	nameRegex      *regexp.Regexp
+	categoryPrefix string
+	fields         map[string]interface{}
+}
+
+func newListMatcher(d *schema.ResourceData) (listMatcher, error) {
+	matcher := listMatcher{
+		categoryPrefix: d.Get("category_prefix").(string),
+		fields:         d.Get("fields").(map[string]interface{}),
+	}
+	if nameRegex := d.Get("name_regex").(string); nameRegex != "" {
+		re, err := regexp.Compile(nameRegex)
+		if err != nil {
+			return matcher, fmt.Errorf("invalid name_regex: %s", err)
+		}
+		matcher.nameRegex = re
+	}
+	return matcher, nil
+}
+
+// matches returns whether the name matches the regex, the category starts
+// with the prefix and every field has the given value.
+func (m listMatcher) matches(name, category string, fields map[string]interface{}) bool {
+	if m.nameRegex != nil && !m.nameRegex.MatchString(name) {
+		return false
+	}
+	if !strings.HasPrefix(category, m.categoryPrefix) {
+		return false
+	}
+	for key, value := range m.fields {
+		if fields[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// listID derives the ID of a list data source from the IDs it found.
+func listID(ids []int64) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+	return strconv.Itoa(hashcode.String(strings.Join(parts, ",")))
+}
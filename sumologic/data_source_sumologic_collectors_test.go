@@ -0,0 +1,65 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitDataSourceSumologicCollectors(t *testing.T) {
+	// Small pages make the data source go through several of them.
+	defer func(pageSize int) { listPageSize = pageSize }(listPageSize)
+	listPageSize = 2
+
+	api := newFakeSumoAPI(t)
+	for id, collector := range map[int]map[string]interface{}{
+		101: {"name": "web-01", "collectorType": "Installable", "category": "prod/web", "alive": true,
+			"fields": map[string]interface{}{"team": "web"}},
+		102: {"name": "web-02", "collectorType": "Installable", "category": "prod/web", "alive": false,
+			"fields": map[string]interface{}{"team": "web"}},
+		103: {"name": "db-01", "collectorType": "Installable", "category": "prod/db", "alive": true},
+		104: {"name": "web-hosted", "collectorType": "Hosted", "category": "prod/web",
+			"fields": map[string]interface{}{"team": "web"}},
+		105: {"name": "web-staging", "collectorType": "Installable", "category": "staging/web", "alive": true},
+	} {
+		collector["id"] = id
+		api.seed(fmt.Sprintf("v1/collectors/%d", id), collector)
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: `
+data "sumologic_collectors" "all" {
+}
+
+data "sumologic_collectors" "installed_web" {
+	collector_type = "Installable"
+	category_prefix = "prod/"
+	name_regex = "^web-"
+}
+
+data "sumologic_collectors" "alive_team_web" {
+	alive = true
+	fields = {
+		team = "web"
+	}
+}
+
+data "sumologic_collectors" "dead" {
+	alive = false
+}`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.sumologic_collectors.all", "ids.#", "5"),
+				resource.TestCheckResourceAttr("data.sumologic_collectors.installed_web", "ids.#", "2"),
+				resource.TestCheckResourceAttr("data.sumologic_collectors.installed_web", "ids.0", "101"),
+				resource.TestCheckResourceAttr("data.sumologic_collectors.installed_web", "ids.1", "102"),
+				resource.TestCheckResourceAttr("data.sumologic_collectors.alive_team_web", "ids.#", "1"),
+				resource.TestCheckResourceAttr("data.sumologic_collectors.alive_team_web", "collectors.0.name", "web-01"),
+				resource.TestCheckResourceAttr("data.sumologic_collectors.alive_team_web", "collectors.0.collector_type", "Installable"),
+				resource.TestCheckResourceAttr("data.sumologic_collectors.alive_team_web", "collectors.0.fields.team", "web"),
+				resource.TestCheckResourceAttr("data.sumologic_collectors.dead", "ids.#", "2"),
+			),
+		},
+	)
+}
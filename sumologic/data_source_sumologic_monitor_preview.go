@@ -0,0 +1,265 @@
+package sumologic
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// dataSourceSumologicMonitorPreview replays the trigger conditions of a logs
+// monitor over a historical window. The query is counted per time range with
+// a search job, and the triggers are evaluated once per time range. The time
+// ranges are tumbling, aligned time slices rather than the rolling window of
+// monitors, and only logs static and missing data conditions are supported.
+func dataSourceSumologicMonitorPreview() *schema.Resource {
+	triggerConditions := triggerConditionsSchema()
+	triggerConditions.Optional = false
+	triggerConditions.Required = true
+	triggerConditions.ConflictsWith = nil
+
+	return &schema.Resource{
+		Read: dataSourceSumologicMonitorPreviewRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"queries": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				// Logs monitors have a single query.
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"row_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"query": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"trigger_conditions": triggerConditions,
+			"window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "24h",
+				ValidateFunc: validateMonitorPreviewWindow,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"trigger_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_range": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"evaluations": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"fired": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"resolved": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"alerting": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateMonitorPreviewWindow(i interface{}, k string) (warnings []string, errors []error) {
+	window, err := time.ParseDuration(i.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s is not a valid duration: %s", k, err))
+	} else if window <= 0 {
+		errors = append(errors, fmt.Errorf("%s must be positive, got %s", k, i))
+	}
+	return warnings, errors
+}
+
+func dataSourceSumologicMonitorPreviewRead(d *schema.ResourceData, meta interface{}) error {
+	c, cancel := meta.(*Client).withTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	query := d.Get("queries.0.query").(string)
+	conditions := firstBlock(d.Get("trigger_conditions"))
+	var triggers []TriggerCondition
+	for _, block := range triggerConditionBlocks {
+		if list, _ := conditions[block].([]interface{}); len(list) == 0 {
+			continue
+		}
+		if block != "logs_static_condition" && block != "logs_missing_data_condition" {
+			return fmt.Errorf("trigger_conditions.0.%s is not supported by the preview, "+
+				"only logs_static_condition and logs_missing_data_condition are, evaluated over aligned "+
+				"time slices rather than the rolling window of the monitor", block)
+		}
+		condition := firstBlock(conditions[block])
+		if condition == nil {
+			condition = map[string]interface{}{}
+		}
+		expanded := expandTriggerCondition(triggerConditionDetectionMethods[block], condition)
+		if len(expanded) == 0 {
+			return fmt.Errorf("trigger_conditions.0.%s must contain a critical or warning block", block)
+		}
+		triggers = append(triggers, expanded...)
+	}
+	if len(triggers) == 0 {
+		return fmt.Errorf("trigger_conditions must contain a condition")
+	}
+
+	window, _ := time.ParseDuration(d.Get("window").(string))
+	end := time.Now().UTC()
+	if endTime := d.Get("end_time").(string); endTime != "" {
+		end, _ = time.Parse(time.RFC3339, endTime)
+	}
+
+	counts := map[string][]float64{}
+	var results []interface{}
+	// The triggers come in pairs of an alerting trigger and the trigger that
+	// resolves it.
+	for i := 0; i+1 < len(triggers); i += 2 {
+		alert, resolution := triggers[i], triggers[i+1]
+		timeRange := strings.TrimPrefix(alert.TimeRange, "-")
+		slices, ok := counts[timeRange]
+		if !ok {
+			var err error
+			slices, err = countMonitorPreviewQuery(c, query, timeRange, end, window)
+			if err != nil {
+				return err
+			}
+			counts[timeRange] = slices
+		}
+
+		fired, resolved, alerting := 0, 0, false
+		for _, count := range slices {
+			if !alerting && monitorPreviewMatches(alert, count) {
+				fired++
+				alerting = true
+			} else if alerting && monitorPreviewMatches(resolution, count) {
+				resolved++
+				alerting = false
+			}
+		}
+		results = append(results, map[string]interface{}{
+			"trigger_type": alert.TriggerType,
+			"time_range":   timeRange,
+			"evaluations":  len(slices),
+			"fired":        fired,
+			"resolved":     resolved,
+			"alerting":     alerting,
+		})
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%q %v %s %s", query, triggers, window, end))))
+	d.Set("end_time", end.Format(time.RFC3339))
+	d.Set("start_time", end.Add(-window).Format(time.RFC3339))
+	if err := d.Set("triggers", results); err != nil {
+		return fmt.Errorf("error setting triggers for datasource %s: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// countMonitorPreviewQuery returns the number of results of the query in each
+// time range that fits in the window, oldest first. The time ranges are
+// aligned to their length, like the time slices of a search.
+func countMonitorPreviewQuery(c *Client, query, timeRange string, end time.Time, window time.Duration) ([]float64, error) {
+	slice, err := monitorTimeRangeDuration(timeRange)
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]float64, int(window/slice))
+	if len(counts) == 0 {
+		return nil, fmt.Errorf("window must be at least the time range %s", timeRange)
+	}
+	to := end.Truncate(slice)
+	from := to.Add(-time.Duration(len(counts)) * slice)
+
+	records, err := c.RunSearchJob(SearchJob{
+		Query:    fmt.Sprintf("%s\n| timeslice %s\n| count by _timeslice", query, timeRange),
+		From:     from.Format("2006-01-02T15:04:05"),
+		To:       to.Format("2006-01-02T15:04:05"),
+		TimeZone: "UTC",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		timeslice, err := strconv.ParseInt(record["_timeslice"], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the time slice of %v: %s", record, err)
+		}
+		count, err := strconv.ParseFloat(record["_count"], 64)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the count of %v: %s", record, err)
+		}
+		if i := int(time.Unix(0, timeslice*int64(time.Millisecond)).Sub(from) / slice); i >= 0 && i < len(counts) {
+			counts[i] += count
+		}
+	}
+	return counts, nil
+}
+
+// monitorTimeRangeDuration parses a time range of a monitor, e.g. -15m or 1d.
+func monitorTimeRangeDuration(timeRange string) (time.Duration, error) {
+	timeRange = strings.TrimPrefix(timeRange, "-")
+	if days := strings.TrimSuffix(timeRange, "d"); days != timeRange {
+		n, err := strconv.Atoi(days)
+		return time.Duration(n) * 24 * time.Hour, err
+	}
+	return time.ParseDuration(timeRange)
+}
+
+func monitorPreviewMatches(trigger TriggerCondition, count float64) bool {
+	switch trigger.TriggerType {
+	case "MissingData":
+		return count == 0
+	case "ResolvedMissingData":
+		return count > 0
+	}
+	switch trigger.ThresholdType {
+	case "LessThan":
+		return count < trigger.threshold()
+	case "LessThanOrEqual":
+		return count <= trigger.threshold()
+	case "GreaterThan":
+		return count > trigger.threshold()
+	case "GreaterThanOrEqual":
+		return count >= trigger.threshold()
+	}
+	return false
+}
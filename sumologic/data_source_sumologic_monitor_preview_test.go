@@ -0,0 +1,110 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestUnitDataSourceSumologicMonitorPreview(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	midnight := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	messages := map[time.Duration]int{
+		15 * time.Minute:  10,
+		30 * time.Minute:  7,
+		45 * time.Minute:  2,
+		90 * time.Minute:  6,
+		105 * time.Minute: 6,
+		// After the window.
+		125 * time.Minute: 20,
+	}
+	for offset, count := range messages {
+		for i := 0; i < count; i++ {
+			api.messages = append(api.messages, midnight.Add(offset+time.Duration(i)*time.Second))
+		}
+	}
+
+	config := func(window, conditions string) string {
+		return fmt.Sprintf(`
+data "sumologic_monitor_preview" "errors" {
+	queries {
+		row_id = "A"
+		query = "_sourceCategory=prod error"
+	}
+	trigger_conditions {
+		%s
+	}
+	window = "%s"
+	end_time = "2026-01-01T02:10:00Z"
+}`, conditions, window)
+	}
+	conditions := `
+		logs_static_condition {
+			critical {
+				time_range = "15m"
+				alert {
+					threshold = 5
+					threshold_type = "GreaterThan"
+				}
+			}
+		}
+		logs_missing_data_condition {
+			time_range = "30m"
+		}`
+	checkJobsDeleted := func(*terraform.State) error {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		if len(api.jobs) > 0 {
+			return fmt.Errorf("%d search jobs were not deleted", len(api.jobs))
+		}
+		return nil
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: config("2h", `
+		metrics_static_condition {
+			critical {
+				time_range = "15m"
+				occurrence_type = "Always"
+				alert {
+					threshold = 5
+					threshold_type = "GreaterThan"
+				}
+			}
+		}`),
+			ExpectError: regexp.MustCompile("trigger_conditions.0.metrics_static_condition is not supported by the preview"),
+		},
+		resource.TestStep{
+			Config:      config("1h", `logs_missing_data_condition { time_range = "24h" }`),
+			ExpectError: regexp.MustCompile("window must be at least the time range 24h"),
+		},
+		resource.TestStep{
+			Config:      config("-1h", conditions),
+			ExpectError: regexp.MustCompile("window must be positive"),
+		},
+		resource.TestStep{
+			Config: config("2h", conditions),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "start_time", "2026-01-01T00:10:00Z"),
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "triggers.#", "2"),
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "triggers.0.trigger_type", "Critical"),
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "triggers.0.time_range", "15m"),
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "triggers.0.evaluations", "8"),
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "triggers.0.fired", "2"),
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "triggers.0.resolved", "1"),
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "triggers.0.alerting", "true"),
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "triggers.1.trigger_type", "MissingData"),
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "triggers.1.evaluations", "4"),
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "triggers.1.fired", "1"),
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "triggers.1.resolved", "1"),
+				resource.TestCheckResourceAttr("data.sumologic_monitor_preview.errors", "triggers.1.alerting", "false"),
+				checkJobsDeleted,
+			),
+		},
+	)
+}
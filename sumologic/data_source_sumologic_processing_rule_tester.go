@@ -0,0 +1,127 @@
+package sumologic
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// dataSourceSumologicProcessingRuleTest evaluates source filters against
+// sample log lines, without calling the API.
+func dataSourceSumologicProcessingRuleTest() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSumologicProcessingRuleTestRead,
+
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     sourceFilterResource(),
+			},
+			"log_lines": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"line": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kept": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"forwarded": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"output": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"matched_filters": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"kept_lines": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"forwarded_lines": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSumologicProcessingRuleTestRead(d *schema.ResourceData, meta interface{}) error {
+	filters := getFilters(d)
+	lines := getStringList(d, "log_lines")
+
+	results, err := evaluateFilters(filters, lines)
+	if err != nil {
+		return err
+	}
+
+	keptLines := []string{}
+	forwardedLines := []string{}
+	rawResults := make([]map[string]interface{}, 0, len(results))
+	for _, result := range results {
+		if result.Kept {
+			keptLines = append(keptLines, result.Output)
+		}
+		if result.Forwarded {
+			forwardedLines = append(forwardedLines, result.Output)
+		}
+		rawResults = append(rawResults, map[string]interface{}{
+			"line":            result.Line,
+			"kept":            result.Kept,
+			"forwarded":       result.Forwarded,
+			"output":          result.Output,
+			"matched_filters": result.MatchedFilters,
+		})
+	}
+
+	var key strings.Builder
+	for _, filter := range filters {
+		fmt.Fprintf(&key, "%q %q %q %q\n", filter.Name, filter.FilterType, filter.Regexp, filter.Mask)
+	}
+	for _, line := range lines {
+		fmt.Fprintf(&key, "%q\n", line)
+	}
+	d.SetId(strconv.Itoa(hashcode.String(key.String())))
+
+	if err := d.Set("results", rawResults); err != nil {
+		return fmt.Errorf("error setting results for datasource %s: %s", d.Id(), err)
+	}
+	if err := d.Set("kept_lines", keptLines); err != nil {
+		return fmt.Errorf("error setting kept lines for datasource %s: %s", d.Id(), err)
+	}
+	if err := d.Set("forwarded_lines", forwardedLines); err != nil {
+		return fmt.Errorf("error setting forwarded lines for datasource %s: %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package sumologic
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitDataSourceSumologicProcessingRuleTest(t *testing.T) {
+	api := newFakeSumoAPI(t)
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: `
+data "sumologic_processing_rule_test" "test" {
+	filters {
+		name = "unclosed"
+		filter_type = "Exclude"
+		regexp = ".*(debug.*"
+	}
+	log_lines = ["debug"]
+}`,
+			ExpectError: regexp.MustCompile("is not a valid regular expression"),
+		},
+		resource.TestStep{
+			Config: `
+data "sumologic_processing_rule_test" "test" {
+	filters {
+		name = "no health checks"
+		filter_type = "Exclude"
+		regexp = ".*GET /health.*"
+	}
+	filters {
+		name = "tokens"
+		filter_type = "Mask"
+		regexp = ".*token=(\\w+).*"
+		mask = "<redacted>"
+	}
+	filters {
+		name = "errors"
+		filter_type = "Forward"
+		regexp = ".* 5\\d\\d .*"
+	}
+	log_lines = [
+		"GET /health 200 1ms",
+		"GET /api?token=abc123 200 5ms",
+		"POST /api 503 30ms",
+	]
+}`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.sumologic_processing_rule_test.test", "results.#", "3"),
+				resource.TestCheckResourceAttr("data.sumologic_processing_rule_test.test", "results.0.kept", "false"),
+				resource.TestCheckResourceAttr("data.sumologic_processing_rule_test.test", "results.0.matched_filters.0", "no health checks"),
+				resource.TestCheckResourceAttr("data.sumologic_processing_rule_test.test", "results.1.output", "GET /api?token=<redacted> 200 5ms"),
+				resource.TestCheckResourceAttr("data.sumologic_processing_rule_test.test", "results.2.forwarded", "true"),
+				resource.TestCheckResourceAttr("data.sumologic_processing_rule_test.test", "kept_lines.#", "2"),
+				resource.TestCheckResourceAttr("data.sumologic_processing_rule_test.test", "forwarded_lines.#", "1"),
+				resource.TestCheckResourceAttr("data.sumologic_processing_rule_test.test", "forwarded_lines.0", "POST /api 503 30ms"),
+			),
+		},
+	)
+}
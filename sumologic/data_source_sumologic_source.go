@@ -0,0 +1,172 @@
+package sumologic
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// dataSourceSumologicSource reads a source of any type, e.g. one created by
+// another Terraform stack or in the UI.
+func dataSourceSumologicSource() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSumologicSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"collector_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"collector_name"},
+			},
+			"collector_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"collector_id"},
+			},
+			"id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"host_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"timezone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fields": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"filter_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"regexp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mask": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSumologicSourceRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	var collectorID int64
+	if cid, ok := d.GetOk("collector_id"); ok {
+		collectorID = int64(cid.(int))
+	} else if cname, ok := d.GetOk("collector_name"); ok {
+		collector, err := c.GetCollectorName(cname.(string))
+		if err != nil {
+			return err
+		}
+		collectorID = collector.ID
+	} else {
+		return errors.New("please specify either collector_id or collector_name")
+	}
+
+	var sourceID int
+	if sid, ok := d.GetOk("id"); ok {
+		sourceID = sid.(int)
+	} else if sname, ok := d.GetOk("name"); ok {
+		sources, err := c.ListSources(collectorID)
+		if err != nil {
+			return err
+		}
+		for _, source := range sources {
+			if source.Name == sname.(string) {
+				sourceID = source.ID
+				break
+			}
+		}
+		if sourceID == 0 {
+			return fmt.Errorf("source with name '%s' does not exist on collector %d", sname.(string), collectorID)
+		}
+	} else {
+		return errors.New("please specify either id or name")
+	}
+
+	source, definition, err := c.GetSourceDefinition(collectorID, sourceID)
+	if err != nil {
+		return err
+	}
+	if source == nil {
+		return fmt.Errorf("source with id %d does not exist on collector %d", sourceID, collectorID)
+	}
+
+	d.SetId(strconv.Itoa(source.ID))
+	d.Set("collector_id", collectorID)
+	d.Set("name", source.Name)
+	d.Set("source_type", source.Type)
+	d.Set("description", source.Description)
+	d.Set("category", source.Category)
+	d.Set("host_name", source.HostName)
+	d.Set("timezone", source.TimeZone)
+	if err := d.Set("fields", source.Fields); err != nil {
+		return fmt.Errorf("error setting fields for datasource %s: %s", d.Id(), err)
+	}
+	d.Set("url", source.Url)
+	d.Set("content_type", source.ContentType)
+	if err := d.Set("filters", flattenFilters(source.Filters)); err != nil {
+		return fmt.Errorf("error setting filters for datasource %s: %s", d.Id(), err)
+	}
+	d.Set("json", string(definition))
+
+	log.Printf("[DEBUG] data_source_sumologic_source: retrieved %s source %d of collector %d", source.Type, source.ID, collectorID)
+	return nil
+}
@@ -0,0 +1,64 @@
+package sumologic
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitDataSourceSumologicSource(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	api.seed("v1/collectors/42", map[string]interface{}{
+		"id": 42, "name": "hosted", "collectorType": "Hosted",
+	})
+	api.seed("v1/collectors/42/sources/7", map[string]interface{}{
+		"id": 7, "name": "cloudtrail", "sourceType": "Polling", "contentType": "AwsCloudTrailBucket",
+		"category": "aws/cloudtrail", "fields": map[string]interface{}{"account": "prod"},
+		"filters": []interface{}{map[string]interface{}{
+			"name": "exclude reads", "filterType": "Exclude", "regexp": ".*\"readOnly\":true.*",
+		}},
+		"thirdPartyRef": map[string]interface{}{
+			"resources": []interface{}{map[string]interface{}{"serviceType": "AwsCloudTrailBucket"}},
+		},
+	})
+	api.seed("v1/collectors/42/sources/8", map[string]interface{}{
+		"id": 8, "name": "http", "sourceType": "HTTP", "url": "https://collectors.example.com/receiver/v1/http/token",
+	})
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: `
+data "sumologic_source" "by_name" {
+	collector_name = "hosted"
+	name = "cloudtrail"
+}
+
+data "sumologic_source" "by_id" {
+	collector_id = 42
+	id = 8
+}`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.sumologic_source.by_name", "id", "7"),
+				resource.TestCheckResourceAttr("data.sumologic_source.by_name", "collector_id", "42"),
+				resource.TestCheckResourceAttr("data.sumologic_source.by_name", "source_type", "Polling"),
+				resource.TestCheckResourceAttr("data.sumologic_source.by_name", "content_type", "AwsCloudTrailBucket"),
+				resource.TestCheckResourceAttr("data.sumologic_source.by_name", "fields.account", "prod"),
+				resource.TestCheckResourceAttr("data.sumologic_source.by_name", "filters.0.filter_type", "Exclude"),
+				resource.TestMatchResourceAttr("data.sumologic_source.by_name", "json",
+					regexp.MustCompile(`"thirdPartyRef":\{"resources":\[\{"serviceType":"AwsCloudTrailBucket"\}\]\}`)),
+				resource.TestCheckResourceAttr("data.sumologic_source.by_id", "name", "http"),
+				resource.TestCheckResourceAttr("data.sumologic_source.by_id", "url",
+					"https://collectors.example.com/receiver/v1/http/token"),
+			),
+		},
+		resource.TestStep{
+			Config: `
+data "sumologic_source" "missing" {
+	collector_id = 42
+	name = "missing"
+}`,
+			ExpectError: regexp.MustCompile("source with name 'missing' does not exist on collector 42"),
+		},
+	)
+}
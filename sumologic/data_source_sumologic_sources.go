@@ -0,0 +1,152 @@
+package sumologic
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceSumologicSources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSumologicSourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"collector_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"category_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"fields": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"sources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fields": {
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSumologicSourcesRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	collectorID := d.Get("collector_id").(int)
+	sources, err := c.ListSources(int64(collectorID))
+	if err != nil {
+		return err
+	}
+
+	matcher, err := newListMatcher(d)
+	if err != nil {
+		return err
+	}
+	sourceType := d.Get("source_type").(string)
+
+	var ids []int64
+	var matches []map[string]interface{}
+	for _, source := range sources {
+		if !matcher.matches(source.Name, source.Category, source.Fields) {
+			continue
+		}
+		if sourceType != "" && source.Type != sourceType {
+			continue
+		}
+		ids = append(ids, int64(source.ID))
+		matches = append(matches, map[string]interface{}{
+			"id":           source.ID,
+			"name":         source.Name,
+			"description":  source.Description,
+			"category":     source.Category,
+			"source_type":  source.Type,
+			"content_type": source.ContentType,
+			"host_name":    source.HostName,
+			"timezone":     source.TimeZone,
+			"fields":       source.Fields,
+			"url":          source.Url,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", collectorID, listID(ids)))
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids for datasource %s: %s", d.Id(), err)
+	}
+	if err := d.Set("sources", matches); err != nil {
+		return fmt.Errorf("error setting sources for datasource %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] data_source_sumologic_sources: %d of %d sources of collector %d match",
+		len(ids), len(sources), collectorID)
+	return nil
+}
@@ -0,0 +1,60 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitDataSourceSumologicSources(t *testing.T) {
+	defer func(pageSize int) { listPageSize = pageSize }(listPageSize)
+	listPageSize = 2
+
+	api := newFakeSumoAPI(t)
+	api.seed("v1/collectors/42", map[string]interface{}{
+		"id": 42, "name": "host-collector", "collectorType": "Installable",
+	})
+	for id, source := range map[int]map[string]interface{}{
+		1: {"name": "messages", "sourceType": "LocalFile", "category": "prod/os/messages"},
+		2: {"name": "secure", "sourceType": "LocalFile", "category": "prod/os/secure",
+			"fields": map[string]interface{}{"pii": "true"}},
+		3: {"name": "syslog", "sourceType": "Syslog", "category": "prod/network"},
+		4: {"name": "app", "sourceType": "LocalFile", "category": "staging/app"},
+	} {
+		source["id"] = id
+		api.seed(fmt.Sprintf("v1/collectors/42/sources/%d", id), source)
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: `
+data "sumologic_sources" "all" {
+	collector_id = 42
+}
+
+data "sumologic_sources" "prod_files" {
+	collector_id = 42
+	source_type = "LocalFile"
+	category_prefix = "prod/"
+}
+
+data "sumologic_sources" "pii" {
+	collector_id = 42
+	name_regex = "^s"
+	fields = {
+		pii = "true"
+	}
+}`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.sumologic_sources.all", "ids.#", "4"),
+				resource.TestCheckResourceAttr("data.sumologic_sources.prod_files", "ids.#", "2"),
+				resource.TestCheckResourceAttr("data.sumologic_sources.prod_files", "sources.0.name", "messages"),
+				resource.TestCheckResourceAttr("data.sumologic_sources.prod_files", "sources.1.name", "secure"),
+				resource.TestCheckResourceAttr("data.sumologic_sources.pii", "ids.#", "1"),
+				resource.TestCheckResourceAttr("data.sumologic_sources.pii", "ids.0", "2"),
+				resource.TestCheckResourceAttr("data.sumologic_sources.pii", "sources.0.source_type", "LocalFile"),
+			),
+		},
+	)
+}
@@ -1,46 +1,123 @@
 package sumologic
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
-	"strings"
+	"time"
 
-	"github.com/go-errors/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
 
 func Provider() terraform.ResourceProvider {
 	log.Printf("Sumo Logic Terraform Provider Version=%s\n", ProviderVersion)
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SUMOLOGIC_ACCESSID", nil),
 			},
 			"access_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("SUMOLOGIC_ACCESSKEY", nil),
 			},
-			"environment": {
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SUMOLOGIC_PROFILE", nil),
+			},
+			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SUMOLOGIC_ENVIRONMENT", nil),
+				DefaultFunc: schema.EnvDefaultFunc("SUMOLOGIC_SHARED_CREDENTIALS_FILE", defaultCredentialsFile),
+			},
+			"credentials_command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SUMOLOGIC_CREDENTIALS_COMMAND", nil),
+			},
+			"environment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SUMOLOGIC_ENVIRONMENT", nil),
+				ValidateFunc: validation.StringInSlice(environments(), false),
 			},
 			"base_url": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Default:  os.Getenv("SUMOLOGIC_BASE_URL"),
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(defaultRetryMaxWait / time.Second),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"requests_per_minute": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultRequestsPerMinute,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultBurst,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"http_proxy": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ca_bundle_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"insecure_skip_verify": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"client_cert_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_key_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"debug_http": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SUMOLOGIC_DEBUG_HTTP", false),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"sumologic_collector":                          resourceSumologicCollector(),
+			"sumologic_installed_collector":                resourceSumologicInstalledCollector(),
 			"sumologic_http_source":                        resourceSumologicHTTPSource(),
 			"sumologic_gcp_source":                         resourceSumologicGCPSource(),
+			"sumologic_azure_event_hub_log_source":         resourceSumologicAzureEventHubLogSource(),
+			"sumologic_azure_event_hub_metrics_source":     resourceSumologicAzureEventHubMetricsSource(),
+			"sumologic_azure_blob_source":                  resourceSumologicAzureBlobSource(),
 			"sumologic_polling_source":                     resourceSumologicPollingSource(),
 			"sumologic_s3_source":                          resourceSumologicGenericPollingSource(),
 			"sumologic_s3_audit_source":                    resourceSumologicGenericPollingSource(),
@@ -51,8 +128,21 @@ func Provider() terraform.ResourceProvider {
 			"sumologic_elb_source":                         resourceSumologicGenericPollingSource(),
 			"sumologic_cloudfront_source":                  resourceSumologicGenericPollingSource(),
 			"sumologic_cloud_to_cloud_source":              resourceSumologicCloudToCloudSource(),
+			"sumologic_okta_source":                        resourceSumologicOktaSource(),
+			"sumologic_salesforce_source":                  resourceSumologicSalesforceSource(),
+			"sumologic_duo_source":                         resourceSumologicDuoSource(),
+			"sumologic_crowdstrike_source":                 resourceSumologicCrowdStrikeSource(),
+			"sumologic_microsoft_graph_security_source":    resourceSumologicMicrosoftGraphSecuritySource(),
+			"sumologic_netskope_source":                    resourceSumologicNetskopeSource(),
 			"sumologic_metadata_source":                    resourceSumologicMetadataSource(),
 			"sumologic_cloudsyslog_source":                 resourceSumologicCloudsyslogSource(),
+			"sumologic_local_file_source":                  resourceSumologicLocalFileSource(),
+			"sumologic_remote_file_source":                 resourceSumologicRemoteFileSource(),
+			"sumologic_syslog_source":                      resourceSumologicSyslogSource(),
+			"sumologic_local_windows_event_source":         resourceSumologicLocalWindowsEventSource(),
+			"sumologic_script_source":                      resourceSumologicScriptSource(),
+			"sumologic_docker_log_source":                  resourceSumologicDockerLogSource(),
+			"sumologic_source_pause":                       resourceSumologicSourcePause(),
 			"sumologic_role":                               resourceSumologicRole(),
 			"sumologic_user":                               resourceSumologicUser(),
 			"sumologic_ingest_budget":                      resourceSumologicIngestBudget(),
@@ -65,6 +155,9 @@ func Provider() terraform.ResourceProvider {
 			"sumologic_connection":                         resourceSumologicConnection(),
 			"sumologic_monitor":                            resourceSumologicMonitorsLibraryMonitor(),
 			"sumologic_monitor_folder":                     resourceSumologicMonitorsLibraryFolder(),
+			"sumologic_monitor_mute":                       resourceSumologicMonitorMute(),
+			"sumologic_slo":                                resourceSumologicSlosLibrarySlo(),
+			"sumologic_slo_folder":                         resourceSumologicSlosLibraryFolder(),
 			"sumologic_ingest_budget_v2":                   resourceSumologicIngestBudgetV2(),
 			"sumologic_field":                              resourceSumologicField(),
 			"sumologic_lookup_table":                       resourceSumologicLookupTable(),
@@ -79,75 +172,85 @@ func Provider() terraform.ResourceProvider {
 			"sumologic_admin_recommended_folder": dataSourceSumologicAdminRecommendedFolder(),
 			"sumologic_caller_identity":          dataSourceSumologicCallerIdentity(),
 			"sumologic_collector":                dataSourceSumologicCollector(),
+			"sumologic_collectors":               dataSourceSumologicCollectors(),
 			"sumologic_http_source":              dataSourceSumologicHTTPSource(),
 			"sumologic_personal_folder":          dataSourceSumologicPersonalFolder(),
+			"sumologic_monitor_preview":          dataSourceSumologicMonitorPreview(),
 			"sumologic_my_user_id":               dataSourceSumologicMyUserId(),
+			"sumologic_processing_rule_test":     dataSourceSumologicProcessingRuleTest(),
 			"sumologic_role":                     dataSourceSumologicRole(),
+			"sumologic_source":                   dataSourceSumologicSource(),
+			"sumologic_sources":                  dataSourceSumologicSources(),
 		},
-		ConfigureFunc: providerConfigure,
 	}
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		// Bind every API call to the provider's stop context so that in-flight
+		// requests and job polling are cancelled when Terraform is interrupted.
+		return providerConfigure(d, provider.StopContext(), resolvedEndpoints.cached(discoverEndpoint))
+	}
+	return provider
 }
 
-func resolveRedirectURL(accessId string, accessKey string) (string, error) {
-	req, err := http.NewRequest(http.MethodHead, "https://api.sumologic.com/api/v1/collectors", nil)
-	if err != nil {
-		return "", err
-	}
-	req.SetBasicAuth(accessId, accessKey)
-	client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
-		return http.ErrUseLastResponse
-	}}
-	resp, err := client.Do(req)
+func providerConfigure(d *schema.ResourceData, stopCtx context.Context, resolveEndpoint endpointResolver) (interface{}, error) {
+	creds, err := resolveCredentials(stopCtx, credentialsConfig{
+		AccessID:           d.Get("access_id").(string),
+		AccessKey:          d.Get("access_key").(string),
+		Profile:            d.Get("profile").(string),
+		CredentialsFile:    d.Get("shared_credentials_file").(string),
+		CredentialsCommand: d.Get("credentials_command").(string),
+	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	location := resp.Header.Get("location")
-	if location == "" {
-		// location header not found implies there was no redirect needed
-		// i.e, the desired environment is us1
-		return "https://api.sumologic.com/api/", nil
-	}
-	return strings.Split(location, "v1")[0], nil
-}
+	log.Printf("[DEBUG] Using Sumo Logic credentials from %s", creds.Source)
+	accessId := creds.AccessID
+	accessKey := creds.AccessKey
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	accessId := d.Get("access_id").(string)
-	accessKey := d.Get("access_key").(string)
+	// The environment and base URL configured for the provider win over the
+	// ones that come with the credentials.
 	environment := d.Get("environment").(string)
 	baseUrl := d.Get("base_url").(string)
-
-	msg := ""
-	if accessId == "" {
-		msg = "sumologic provider: access_id should be set;"
+	if environment == "" && baseUrl == "" {
+		environment = creds.Environment
+		baseUrl = creds.BaseURL
 	}
 
-	if accessKey == "" {
-		msg = fmt.Sprintf("%s access_key should be set; ", msg)
+	httpClient, err := newHTTPClient(transportConfig{
+		ProxyURL:           d.Get("http_proxy").(string),
+		CABundleFile:       d.Get("ca_bundle_file").(string),
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		ClientCertFile:     d.Get("client_cert_file").(string),
+		ClientKeyFile:      d.Get("client_key_file").(string),
+		Timeout:            time.Duration(d.Get("request_timeout").(int)) * time.Second,
+		DebugHTTP:          d.Get("debug_http").(bool),
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	if environment == "" && baseUrl == "" {
-		log.Printf("Attempting to resolve redirection URL from access key/id")
-		url, err := resolveRedirectURL(accessId, accessKey)
+		log.Printf("[DEBUG] Resolving the API endpoint for access ID %s", accessId)
+		baseUrl, err = resolveEndpoint(stopCtx, httpClient, accessId, accessKey)
 		if err != nil {
-			log.Printf("[WARN] Unable to resolve redirection URL, %s", err)
-			environment = "us2"
-			// baseUrl will be set accordingly in NewClient constructor
-			log.Printf("[WARN] environment not set, defaulting to %s", environment)
-		} else {
-			baseUrl = url
-			log.Printf("Resolved redirection URL %s", baseUrl)
+			return nil, fmt.Errorf("sumologic provider: unable to discover the API endpoint for access ID %s, "+
+				"set environment or base_url explicitly: %s", accessId, err)
 		}
-
+		log.Printf("[DEBUG] Resolved the API endpoint %s", baseUrl)
 	}
 
-	if msg != "" {
-		return nil, errors.New(msg)
-	}
-
-	return NewClient(
+	client, err := NewClient(
 		accessId,
 		accessKey,
 		environment,
 		baseUrl,
 	)
+	if err != nil {
+		return nil, err
+	}
+	client.httpClient = httpClient
+	client.MaxRetries = d.Get("max_retries").(int)
+	client.RetryMaxWait = time.Duration(d.Get("retry_max_wait").(int)) * time.Second
+	client.limiter = newRateLimiter(d.Get("requests_per_minute").(int), d.Get("burst").(int))
+
+	return client.WithContext(stopCtx), nil
 }
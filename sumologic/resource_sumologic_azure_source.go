@@ -0,0 +1,297 @@
+package sumologic
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSumologicAzureEventHubLogSource() *schema.Resource {
+	return resourceSumologicAzureSource("AzureEventHubLog", "AzureEventHubPath")
+}
+
+func resourceSumologicAzureEventHubMetricsSource() *schema.Resource {
+	return resourceSumologicAzureSource("AzureEventHubMetrics", "AzureEventHubPath")
+}
+
+// resourceSumologicAzureBlobSource collects the blobs an Event Grid
+// subscription of the storage account reports to an event hub.
+func resourceSumologicAzureBlobSource() *schema.Resource {
+	azureSource := resourceSumologicAzureSource("AzureBlobStorage", "AzureBlobPath")
+
+	path := azureSource.Schema["path"].Elem.(*schema.Resource)
+	path.Schema["storage_account_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	path.Schema["container_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	path.Schema["path_expression"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "*",
+	}
+
+	return azureSource
+}
+
+func resourceSumologicAzureSource(contentType, pathType string) *schema.Resource {
+	azureSource := resourceSumologicSource()
+	azureSource.Create = resourceSumologicAzureSourceCreate
+	azureSource.Read = resourceSumologicAzureSourceRead
+	azureSource.Update = resourceSumologicAzureSourceUpdate
+	azureSource.Importer = &schema.ResourceImporter{
+		State: resourceSumologicSourceImport,
+	}
+
+	azureSource.Schema["content_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      contentType,
+		ValidateFunc: validation.StringInSlice([]string{contentType}, false),
+	}
+	azureSource.Schema["authentication"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{"AzureEventHubAuthentication",
+						"AzureSasKeyAuthentication"}, false),
+				},
+				"shared_access_policy_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"shared_access_policy_key": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+				"sas_key_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"sas_key": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+	azureSource.Schema["path"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      pathType,
+					ValidateFunc: validation.StringInSlice([]string{pathType}, false),
+				},
+				"namespace": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"event_hub_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"consumer_group": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "$Default",
+				},
+				"region": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "Commercial",
+					ValidateFunc: validation.StringInSlice([]string{"Commercial", "US Gov"}, false),
+				},
+			},
+		},
+	}
+
+	return azureSource
+}
+
+func resourceSumologicAzureSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	if d.Id() == "" {
+		source, err := resourceToAzureSource(d)
+		if err != nil {
+			return err
+		}
+
+		sourceID, err := c.CreateAzureSource(source, d.Get("collector_id").(int))
+		if err != nil {
+			return err
+		}
+
+		d.SetId(strconv.Itoa(sourceID))
+	}
+
+	return resourceSumologicAzureSourceRead(d, meta)
+}
+
+func resourceSumologicAzureSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	source, err := resourceToAzureSource(d)
+	if err != nil {
+		return err
+	}
+
+	err = c.UpdateAzureSource(source, d.Get("collector_id").(int))
+	if err != nil {
+		return err
+	}
+
+	return resourceSumologicAzureSourceRead(d, meta)
+}
+
+func resourceSumologicAzureSourceRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id, _ := strconv.Atoi(d.Id())
+	source, err := c.GetAzureSource(d.Get("collector_id").(int), id)
+
+	if err != nil {
+		return err
+	}
+
+	if source == nil {
+		log.Printf("[WARN] Azure source not found, removing from state: %v - %v", id, err)
+		d.SetId("")
+
+		return nil
+	}
+
+	if err := resourceSumologicSourceRead(d, source.Source); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	d.Set("content_type", source.ContentType)
+
+	if len(source.ThirdPartyRef.Resources) > 0 {
+		resource := source.ThirdPartyRef.Resources[0]
+		if err := d.Set("authentication", flattenAzureAuthentication(d, resource.Authentication)); err != nil {
+			return err
+		}
+		if err := d.Set("path", flattenAzurePath(resource.Path)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flattenAzureAuthentication keeps the keys from the state, as the API does
+// not return them.
+func flattenAzureAuthentication(d *schema.ResourceData, auth AzureAuthentication) []map[string]interface{} {
+	return []map[string]interface{}{{
+		"type":                      auth.Type,
+		"shared_access_policy_name": auth.SharedAccessPolicyName,
+		"shared_access_policy_key":  d.Get("authentication.0.shared_access_policy_key").(string),
+		"sas_key_name":              auth.SasKeyName,
+		"sas_key":                   d.Get("authentication.0.sas_key").(string),
+	}}
+}
+
+func flattenAzurePath(path AzurePath) []map[string]interface{} {
+	mapping := map[string]interface{}{
+		"type":           path.Type,
+		"namespace":      path.Namespace,
+		"event_hub_name": path.EventHubName,
+		"consumer_group": path.ConsumerGroup,
+		"region":         path.Region,
+	}
+	if path.Type == "AzureBlobPath" {
+		mapping["storage_account_name"] = path.StorageAccountName
+		mapping["container_name"] = path.ContainerName
+		mapping["path_expression"] = path.PathExpression
+	}
+	return []map[string]interface{}{mapping}
+}
+
+func resourceToAzureSource(d *schema.ResourceData) (AzureSource, error) {
+	source := resourceToSource(d)
+	source.Type = "Universal"
+
+	azureSource := AzureSource{
+		Source: source,
+	}
+
+	authSettings, err := getAzureAuthentication(d)
+	if err != nil {
+		return azureSource, err
+	}
+
+	azureResource := AzureResource{
+		ServiceType:    d.Get("content_type").(string),
+		Authentication: authSettings,
+		Path:           getAzurePath(d),
+	}
+
+	azureSource.ThirdPartyRef.Resources = append(azureSource.ThirdPartyRef.Resources, azureResource)
+
+	return azureSource, nil
+}
+
+func getAzureAuthentication(d *schema.ResourceData) (AzureAuthentication, error) {
+	auth := d.Get("authentication").([]interface{})[0].(map[string]interface{})
+	authSettings := AzureAuthentication{
+		Type: auth["type"].(string),
+	}
+
+	switch authSettings.Type {
+	case "AzureEventHubAuthentication":
+		authSettings.SharedAccessPolicyName = auth["shared_access_policy_name"].(string)
+		authSettings.SharedAccessPolicyKey = auth["shared_access_policy_key"].(string)
+		if authSettings.SharedAccessPolicyName == "" || authSettings.SharedAccessPolicyKey == "" {
+			return authSettings, errors.New(
+				"shared_access_policy_name and shared_access_policy_key must be set for AzureEventHubAuthentication")
+		}
+	case "AzureSasKeyAuthentication":
+		authSettings.SasKeyName = auth["sas_key_name"].(string)
+		authSettings.SasKey = auth["sas_key"].(string)
+		if authSettings.SasKeyName == "" || authSettings.SasKey == "" {
+			return authSettings, errors.New("sas_key_name and sas_key must be set for AzureSasKeyAuthentication")
+		}
+	}
+
+	return authSettings, nil
+}
+
+func getAzurePath(d *schema.ResourceData) AzurePath {
+	path := d.Get("path").([]interface{})[0].(map[string]interface{})
+	pathSettings := AzurePath{
+		Type:          path["type"].(string),
+		Namespace:     path["namespace"].(string),
+		EventHubName:  path["event_hub_name"].(string),
+		ConsumerGroup: path["consumer_group"].(string),
+		Region:        path["region"].(string),
+	}
+	if pathSettings.Type == "AzureBlobPath" {
+		pathSettings.StorageAccountName = path["storage_account_name"].(string)
+		pathSettings.ContainerName = path["container_name"].(string)
+		pathSettings.PathExpression = path["path_expression"].(string)
+	}
+	return pathSettings
+}
@@ -0,0 +1,142 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestUnitSumologicAzureSources(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := func(policyKey string) string {
+		return fmt.Sprintf(`
+resource "sumologic_collector" "test" {
+	name = "collector"
+}
+
+resource "sumologic_azure_event_hub_log_source" "test" {
+	collector_id = sumologic_collector.test.id
+	name = "activity-logs"
+	authentication {
+		type = "AzureEventHubAuthentication"
+		shared_access_policy_name = "sumo"
+		shared_access_policy_key = "%s"
+	}
+	path {
+		namespace = "logs-ns"
+		event_hub_name = "activity"
+	}
+}
+
+resource "sumologic_azure_event_hub_metrics_source" "test" {
+	collector_id = sumologic_collector.test.id
+	name = "metrics"
+	authentication {
+		type = "AzureSasKeyAuthentication"
+		sas_key_name = "RootManageSharedAccessKey"
+		sas_key = "sas-secret"
+	}
+	path {
+		namespace = "metrics-ns"
+		event_hub_name = "metrics"
+		consumer_group = "sumo"
+		region = "US Gov"
+	}
+}
+
+resource "sumologic_azure_blob_source" "test" {
+	collector_id = sumologic_collector.test.id
+	name = "blobs"
+	authentication {
+		type = "AzureSasKeyAuthentication"
+		sas_key_name = "RootManageSharedAccessKey"
+		sas_key = "sas-secret"
+	}
+	path {
+		namespace = "blob-ns"
+		event_hub_name = "blob-events"
+		storage_account_name = "logsaccount"
+		container_name = "insights-logs"
+		path_expression = "resourceId=*/y=2021/*"
+	}
+}`, policyKey)
+	}
+	sourcePath := func(attributes map[string]string) string {
+		return fmt.Sprintf("v1/collectors/%s/sources/%s", attributes["collector_id"], attributes["id"])
+	}
+	// The sources are created concurrently, so their IDs are looked up.
+	var logSourcePath string
+	importStep := func(name string) resource.TestStep {
+		return resource.TestStep{
+			ResourceName:      name,
+			ImportState:       true,
+			ImportStateIdFunc: testImportStateIdFunc(name, "collector_id", "id"),
+			ImportStateVerify: true,
+			// The keys are not read back.
+			ImportStateVerifyIgnore: []string{"authentication.0.shared_access_policy_key", "authentication.0.sas_key"},
+		}
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config:      config(""),
+			ExpectError: regexp.MustCompile("shared_access_policy_name and shared_access_policy_key must be set"),
+		},
+		resource.TestStep{
+			Config: config("policy-secret"),
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_azure_event_hub_log_source.test", sourcePath, map[string]interface{}{
+					"sourceType": "Universal", "name": "activity-logs",
+				}),
+				api.checkObject("sumologic_azure_blob_source.test", sourcePath, map[string]interface{}{
+					"sourceType": "Universal", "name": "blobs",
+				}),
+				resource.TestCheckResourceAttr("sumologic_azure_event_hub_log_source.test", "content_type", "AzureEventHubLog"),
+				resource.TestCheckResourceAttr("sumologic_azure_event_hub_log_source.test", "path.0.type", "AzureEventHubPath"),
+				resource.TestCheckResourceAttr("sumologic_azure_event_hub_log_source.test", "path.0.consumer_group", "$Default"),
+				resource.TestCheckResourceAttr("sumologic_azure_event_hub_metrics_source.test", "content_type", "AzureEventHubMetrics"),
+				resource.TestCheckResourceAttr("sumologic_azure_event_hub_metrics_source.test", "path.0.region", "US Gov"),
+				resource.TestCheckResourceAttr("sumologic_azure_blob_source.test", "path.0.type", "AzureBlobPath"),
+				resource.TestCheckResourceAttr("sumologic_azure_blob_source.test", "path.0.container_name", "insights-logs"),
+				func(s *terraform.State) error {
+					logSourcePath = sourcePath(s.RootModule().Resources["sumologic_azure_event_hub_log_source.test"].Primary.Attributes)
+					return nil
+				},
+			),
+		},
+		importStep("sumologic_azure_event_hub_log_source.test"),
+		importStep("sumologic_azure_event_hub_metrics_source.test"),
+		importStep("sumologic_azure_blob_source.test"),
+		resource.TestStep{
+			// The API does not return the keys, the ones from the state are kept.
+			PreConfig: func() {
+				api.update(logSourcePath, func(source map[string]interface{}) {
+					resources := source["thirdPartyRef"].(map[string]interface{})["resources"].([]interface{})
+					auth := resources[0].(map[string]interface{})["authentication"].(map[string]interface{})
+					delete(auth, "sharedAccessPolicyKey")
+				})
+			},
+			Config:   config("policy-secret"),
+			PlanOnly: true,
+		},
+		resource.TestStep{
+			Config: config("rotated-secret"),
+			Check: api.checkObject("sumologic_azure_event_hub_log_source.test", sourcePath, map[string]interface{}{
+				"thirdPartyRef": map[string]interface{}{"resources": []interface{}{map[string]interface{}{
+					"serviceType": "AzureEventHubLog",
+					"authentication": map[string]interface{}{
+						"type": "AzureEventHubAuthentication", "sharedAccessPolicyName": "sumo",
+						"sharedAccessPolicyKey": "rotated-secret",
+					},
+					"path": map[string]interface{}{
+						"type": "AzureEventHubPath", "namespace": "logs-ns", "eventHubName": "activity",
+						"consumerGroup": "$Default", "region": "Commercial",
+					},
+				}}},
+			}),
+		},
+	)
+}
@@ -3,11 +3,13 @@ package sumologic
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
+	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
@@ -25,7 +27,7 @@ func resourceSumologicCloudToCloudSource() *schema.Resource {
 				Type:             schema.TypeString,
 				ValidateFunc:     validation.StringIsJSON,
 				Required:         true,
-				DiffSuppressFunc: structure.SuppressJsonDiff,
+				DiffSuppressFunc: suppressCloudToCloudConfigDiff,
 			},
 			"schema_ref": {
 				Type:     schema.TypeMap,
@@ -39,6 +41,10 @@ func resourceSumologicCloudToCloudSource() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -51,9 +57,6 @@ func resourceSumologicCloudToCloudSourceCreate(d *schema.ResourceData, meta inte
 		if err != nil {
 			return err
 		}
-		log.Printf("SchemaRef %s", source.SchemaRef)
-		log.Printf("Config: %s", source.Config)
-
 		id, err := c.CreateCloudToCloudSource(*source, d.Get("collector_id").(int))
 
 		if err != nil {
@@ -110,6 +113,7 @@ func resourceToCloudToCloudSource(d *schema.ResourceData) (*CloudToCloudSource,
 
 	cloudToCloudSource.ID = id
 	cloudToCloudSource.Config = jsonRawConf
+	cloudToCloudSource.ETag = d.Get("etag").(string)
 	schemaRef, errSchemaRef := getSourceSchemaRef(d)
 
 	if errSchemaRef != nil {
@@ -136,9 +140,140 @@ func resourceSumologicCloudToCloudSourceRead(d *schema.ResourceData, meta interf
 
 		return nil
 	}
+	d.Set("etag", source.ETag)
+
+	config, err := mergeCloudToCloudConfig(source.Config, d.Get("config").(string))
+	if err != nil {
+		return fmt.Errorf("error reading the config of Cloud-to-Cloud source %d: %s", id, err)
+	}
+	d.Set("config", config)
+	d.Set("schema_ref", map[string]interface{}{"type": source.SchemaRef.Type})
 
 	return nil
 }
+
+// isMaskedSecret reports whether v is a secret the API masked, e.g. "********".
+func isMaskedSecret(v interface{}) bool {
+	s, ok := v.(string)
+	return ok && s != "" && strings.Trim(s, "*") == ""
+}
+
+// mergeCloudToCloudConfig returns the config read from the API limited to the
+// keys of the prior config, if any, so that keys the API adds, e.g. defaults,
+// are not in the state, while a key removed from the configuration still is
+// until it is applied. Masked secrets are replaced by the prior ones.
+func mergeCloudToCloudConfig(config json.RawMessage, prior string) (string, error) {
+	var current, previous interface{}
+	if err := json.Unmarshal(config, &current); err != nil {
+		return "", err
+	}
+	// The prior config is empty when importing.
+	if json.Unmarshal([]byte(prior), &previous) == nil {
+		current = withoutAddedKeys(current, previous)
+	}
+
+	merged, err := json.Marshal(restoreMaskedSecrets(current, previous))
+	if err != nil {
+		return "", err
+	}
+	return string(merged), nil
+}
+
+// withoutAddedKeys removes the keys of the objects in current that are not in
+// the matching objects of previous.
+func withoutAddedKeys(current, previous interface{}) interface{} {
+	switch value := current.(type) {
+	case map[string]interface{}:
+		previousMap, ok := previous.(map[string]interface{})
+		if !ok {
+			return current
+		}
+		for k, v := range value {
+			if previousValue, ok := previousMap[k]; ok {
+				value[k] = withoutAddedKeys(v, previousValue)
+			} else {
+				delete(value, k)
+			}
+		}
+	case []interface{}:
+		previousList, _ := previous.([]interface{})
+		for i, v := range value {
+			if i < len(previousList) {
+				value[i] = withoutAddedKeys(v, previousList[i])
+			}
+		}
+	}
+	return current
+}
+
+func restoreMaskedSecrets(current, previous interface{}) interface{} {
+	switch value := current.(type) {
+	case map[string]interface{}:
+		previousMap, _ := previous.(map[string]interface{})
+		for k, v := range value {
+			value[k] = restoreMaskedSecrets(v, previousMap[k])
+		}
+	case []interface{}:
+		previousList, _ := previous.([]interface{})
+		for i, v := range value {
+			if i < len(previousList) {
+				value[i] = restoreMaskedSecrets(v, previousList[i])
+			}
+		}
+	default:
+		if isMaskedSecret(current) && previous != nil {
+			return previous
+		}
+	}
+	return current
+}
+
+// suppressCloudToCloudConfigDiff compares configs semantically: key order
+// and whitespace do not matter and masked secrets match any value. Keys the
+// API adds are not in the state, see mergeCloudToCloudConfig.
+func suppressCloudToCloudConfigDiff(k, old, new string, d *schema.ResourceData) bool {
+	var state, config interface{}
+	if err := json.Unmarshal([]byte(old), &state); err != nil {
+		return old == new
+	}
+	if err := json.Unmarshal([]byte(new), &config); err != nil {
+		return old == new
+	}
+	return cloudToCloudConfigEqual(state, config)
+}
+
+// cloudToCloudConfigEqual reports whether state and config hold the same
+// values, a masked secret in state matching any value.
+func cloudToCloudConfigEqual(state, config interface{}) bool {
+	switch value := config.(type) {
+	case map[string]interface{}:
+		stateMap, ok := state.(map[string]interface{})
+		if !ok || len(stateMap) != len(value) {
+			return false
+		}
+		for k, v := range value {
+			stateValue, ok := stateMap[k]
+			if !ok || !cloudToCloudConfigEqual(stateValue, v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		stateList, ok := state.([]interface{})
+		if !ok || len(stateList) != len(value) {
+			return false
+		}
+		for i, v := range value {
+			if !cloudToCloudConfigEqual(stateList[i], v) {
+				return false
+			}
+		}
+		return true
+	default:
+		return isMaskedSecret(state) || reflect.DeepEqual(state, config)
+	}
+}
+
 func getSourceSchemaRef(d *schema.ResourceData) (SchemaReference, error) {
 	sourceSchema := d.Get("schema_ref").(map[string]interface{})
 	schemaR := SchemaReference{}
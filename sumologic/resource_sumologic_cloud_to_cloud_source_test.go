@@ -3,6 +3,7 @@ package sumologic
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -166,3 +167,86 @@ var updatedConfigJSON = `{
 	},
 	"pollingInterval": 300
 }`
+
+func TestUnitSumologicCloudToCloudSource(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := func(domain string) string {
+		return fmt.Sprintf(`
+resource "sumologic_collector" "test" {
+	name = "collector"
+}
+
+resource "sumologic_cloud_to_cloud_source" "test" {
+	collector_id = sumologic_collector.test.id
+	schema_ref = {
+		type = "Okta"
+	}
+	config = jsonencode({
+		name = "okta"
+		domain = "%s"
+		apiKey = "secret"
+		fields = { _siemForward = false }
+	})
+}`, domain)
+	}
+	sourcePath := func(attributes map[string]string) string {
+		return fmt.Sprintf("v1/collectors/%s/sources/%s", attributes["collector_id"], attributes["id"])
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: config("demo.okta.com"),
+			Check: api.checkObject("sumologic_cloud_to_cloud_source.test", sourcePath, map[string]interface{}{
+				"sourceType": "Universal", "schemaRef": map[string]interface{}{"type": "Okta"},
+			}),
+		},
+		resource.TestStep{
+			// Reordered keys, defaults added by the API and masked secrets
+			// are no changes.
+			PreConfig: func() {
+				api.update("v1/collectors/1/sources/2", func(source map[string]interface{}) {
+					config := source["config"].(map[string]interface{})
+					config["apiKey"] = "********"
+					config["pollingInterval"] = 300
+				})
+			},
+			Config: config("demo.okta.com"),
+			Check: resource.TestCheckResourceAttr("sumologic_cloud_to_cloud_source.test", "config",
+				`{"apiKey":"secret","domain":"demo.okta.com","fields":{"_siemForward":false},"name":"okta"}`),
+		},
+		resource.TestStep{
+			// Removing a key is a change.
+			Config: strings.Replace(config("demo.okta.com"), "fields = { _siemForward = false }", "", 1),
+			Check: api.checkObject("sumologic_cloud_to_cloud_source.test", sourcePath, map[string]interface{}{
+				"config": map[string]interface{}{"name": "okta", "domain": "demo.okta.com", "apiKey": "secret"},
+			}),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_cloud_to_cloud_source.test",
+			ImportState:       true,
+			ImportStateIdFunc: testImportStateIdFunc("sumologic_cloud_to_cloud_source.test", "collector_id", "id"),
+			ImportStateVerify: true,
+			// The imported config holds the masked secret.
+			ImportStateVerifyIgnore: []string{"config"},
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update("v1/collectors/1/sources/2", func(source map[string]interface{}) {
+					source["config"].(map[string]interface{})["domain"] = "other.okta.com"
+				})
+			},
+			Config:             config("demo.okta.com"),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+		resource.TestStep{
+			Config: config("demo.okta.com"),
+			Check: api.checkObject("sumologic_cloud_to_cloud_source.test", sourcePath, map[string]interface{}{
+				"config": map[string]interface{}{
+					"name": "okta", "domain": "demo.okta.com", "apiKey": "secret",
+					"fields": map[string]interface{}{"_siemForward": false},
+				},
+			}),
+		},
+	)
+}
@@ -0,0 +1,318 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// The typed Cloud-to-Cloud sources model the config of an integration as
+// attributes, instead of the JSON document sumologic_cloud_to_cloud_source
+// takes.
+
+// cloudToCloudAttribute is an attribute of a typed Cloud-to-Cloud source.
+type cloudToCloudAttribute struct {
+	// key is the key of the attribute in the config of the source.
+	key    string
+	schema *schema.Schema
+}
+
+var validateCloudToCloudDomain = validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9.-]+$`),
+	"must be a host name without a scheme or path")
+
+func cloudToCloudPollingInterval(defaultSeconds int) cloudToCloudAttribute {
+	return cloudToCloudAttribute{"pollingInterval", &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      defaultSeconds,
+		ValidateFunc: validation.IntBetween(30, 86400),
+	}}
+}
+
+func cloudToCloudString(key string, required bool, validate schema.SchemaValidateFunc) cloudToCloudAttribute {
+	return cloudToCloudAttribute{key, &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     required,
+		Optional:     !required,
+		ValidateFunc: validate,
+	}}
+}
+
+func cloudToCloudSecret(key string) cloudToCloudAttribute {
+	return cloudToCloudAttribute{key, &schema.Schema{
+		Type:      schema.TypeString,
+		Required:  true,
+		Sensitive: true,
+	}}
+}
+
+func resourceSumologicOktaSource() *schema.Resource {
+	return resourceSumologicTypedCloudToCloudSource("Okta", map[string]cloudToCloudAttribute{
+		"domain":  cloudToCloudString("domain", true, validateCloudToCloudDomain),
+		"api_key": cloudToCloudSecret("apiKey"),
+		"collect_all": {"collectAll", &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		}},
+		"polling_interval": cloudToCloudPollingInterval(300),
+	})
+}
+
+func resourceSumologicSalesforceSource() *schema.Resource {
+	return resourceSumologicTypedCloudToCloudSource("Salesforce", map[string]cloudToCloudAttribute{
+		"domain":        cloudToCloudString("domain", true, validateCloudToCloudDomain),
+		"client_id":     cloudToCloudString("clientId", true, nil),
+		"client_secret": cloudToCloudSecret("clientSecret"),
+		"build_in_memory_lookup": {"buildInMemoryLookup", &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		}},
+		"polling_interval": cloudToCloudPollingInterval(300),
+	})
+}
+
+func resourceSumologicDuoSource() *schema.Resource {
+	return resourceSumologicTypedCloudToCloudSource("Duo", map[string]cloudToCloudAttribute{
+		"domain":           cloudToCloudString("domain", true, validateCloudToCloudDomain),
+		"integration_key":  cloudToCloudString("integrationKey", true, nil),
+		"secret_key":       cloudToCloudSecret("secretKey"),
+		"polling_interval": cloudToCloudPollingInterval(300),
+	})
+}
+
+func resourceSumologicCrowdStrikeSource() *schema.Resource {
+	return resourceSumologicTypedCloudToCloudSource("CrowdStrike", map[string]cloudToCloudAttribute{
+		"domain": cloudToCloudString("domain", true, validation.StringInSlice([]string{"api.crowdstrike.com",
+			"api.us-2.crowdstrike.com", "api.eu-1.crowdstrike.com", "api.laggar.gcw.crowdstrike.com"}, false)),
+		"client_id":        cloudToCloudString("clientId", true, nil),
+		"client_secret":    cloudToCloudSecret("clientSecret"),
+		"member_cid":       cloudToCloudString("memberCID", false, nil),
+		"polling_interval": cloudToCloudPollingInterval(300),
+	})
+}
+
+func resourceSumologicMicrosoftGraphSecuritySource() *schema.Resource {
+	return resourceSumologicTypedCloudToCloudSource("Microsoft Graph Security API", map[string]cloudToCloudAttribute{
+		"tenant_id":        cloudToCloudString("tenantId", true, validation.IsUUID),
+		"client_id":        cloudToCloudString("clientId", true, validation.IsUUID),
+		"client_secret":    cloudToCloudSecret("clientSecret"),
+		"polling_interval": cloudToCloudPollingInterval(300),
+	})
+}
+
+func resourceSumologicNetskopeSource() *schema.Resource {
+	return resourceSumologicTypedCloudToCloudSource("Netskope", map[string]cloudToCloudAttribute{
+		"endpoint":  cloudToCloudString("endpoint", true, validateCloudToCloudDomain),
+		"api_token": cloudToCloudSecret("apiToken"),
+		"event_types": {"eventTypes", &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"application", "audit", "connection", "incident",
+					"infrastructure", "network", "page"}, false),
+			},
+		}},
+		"alert_types": {"alertTypes", &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"Anomaly", "Compromised Credential", "policy",
+					"Legal Hold", "malsite", "Malware", "DLP", "Security Assessment", "watchlist", "quarantine",
+					"Remediation", "uba"}, false),
+			},
+		}},
+		"polling_interval": cloudToCloudPollingInterval(300),
+	})
+}
+
+func resourceSumologicTypedCloudToCloudSource(schemaType string, attributes map[string]cloudToCloudAttribute) *schema.Resource {
+	typedSource := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			c := meta.(*Client)
+
+			if d.Id() == "" {
+				source, err := resourceToTypedCloudToCloudSource(d, schemaType, attributes)
+				if err != nil {
+					return err
+				}
+
+				id, err := c.CreateCloudToCloudSource(source, d.Get("collector_id").(int))
+				if err != nil {
+					return err
+				}
+
+				d.SetId(strconv.Itoa(id))
+			}
+
+			return resourceSumologicTypedCloudToCloudSourceRead(d, meta, schemaType, attributes)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceSumologicTypedCloudToCloudSourceRead(d, meta, schemaType, attributes)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			c := meta.(*Client)
+
+			source, err := resourceToTypedCloudToCloudSource(d, schemaType, attributes)
+			if err != nil {
+				return err
+			}
+
+			err = c.UpdateCloudToCloudSource(source, d.Get("collector_id").(int))
+			if err != nil {
+				return err
+			}
+
+			return resourceSumologicTypedCloudToCloudSourceRead(d, meta, schemaType, attributes)
+		},
+		Delete: resourceSumologicCloudToCloudSourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSumologicSourceImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"collector_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fields": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	for name, attribute := range attributes {
+		typedSource.Schema[name] = attribute.schema
+	}
+
+	return typedSource
+}
+
+func resourceSumologicTypedCloudToCloudSourceRead(d *schema.ResourceData, meta interface{}, schemaType string,
+	attributes map[string]cloudToCloudAttribute) error {
+	c := meta.(*Client)
+
+	id, _ := strconv.Atoi(d.Id())
+	source, err := c.GetCloudToCloudSource(d.Get("collector_id").(int), id)
+
+	if err != nil {
+		return err
+	}
+
+	if source == nil {
+		log.Printf("[WARN] %s source not found, removing from state: %v - %v", schemaType, id, err)
+		d.SetId("")
+
+		return nil
+	}
+
+	if source.SchemaRef.Type != schemaType {
+		return fmt.Errorf("source %d is a %s source, not a %s source", id, source.SchemaRef.Type, schemaType)
+	}
+
+	var config map[string]interface{}
+	if err := json.Unmarshal(source.Config, &config); err != nil {
+		return fmt.Errorf("error reading the config of %s source %d: %s", schemaType, id, err)
+	}
+
+	d.Set("etag", source.ETag)
+	d.Set("name", config["name"])
+	d.Set("description", config["description"])
+	d.Set("category", config["category"])
+
+	fields := map[string]interface{}{}
+	if rawFields, ok := config["fields"].(map[string]interface{}); ok {
+		for k, v := range rawFields {
+			fields[k] = fmt.Sprint(v)
+		}
+	}
+	if err := d.Set("fields", fields); err != nil {
+		return err
+	}
+
+	for name, attribute := range attributes {
+		value, ok := config[attribute.key]
+		// The API masks secrets, the ones in the state are kept.
+		if attribute.schema.Sensitive && (!ok || isMaskedSecret(value)) {
+			continue
+		}
+		if number, isNumber := value.(float64); isNumber && attribute.schema.Type == schema.TypeInt {
+			value = int(number)
+		}
+		if err := d.Set(name, value); err != nil {
+			return fmt.Errorf("error setting %s for %s source %d: %s", name, schemaType, id, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceToTypedCloudToCloudSource(d *schema.ResourceData, schemaType string,
+	attributes map[string]cloudToCloudAttribute) (CloudToCloudSource, error) {
+	id, _ := strconv.Atoi(d.Id())
+
+	config := map[string]interface{}{
+		"name":   d.Get("name").(string),
+		"fields": d.Get("fields").(map[string]interface{}),
+	}
+	for _, name := range []string{"description", "category"} {
+		if value := d.Get(name).(string); value != "" {
+			config[name] = value
+		}
+	}
+	for name, attribute := range attributes {
+		value := d.Get(name)
+		switch v := value.(type) {
+		case string:
+			if v == "" {
+				continue
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				continue
+			}
+		}
+		config[attribute.key] = value
+	}
+
+	rawConfig, err := json.Marshal(config)
+	if err != nil {
+		return CloudToCloudSource{}, err
+	}
+
+	return CloudToCloudSource{
+		ID:        id,
+		Type:      "Universal",
+		Config:    rawConfig,
+		SchemaRef: SchemaReference{Type: schemaType},
+		ETag:      d.Get("etag").(string),
+	}, nil
+}
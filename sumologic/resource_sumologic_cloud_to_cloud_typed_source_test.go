@@ -0,0 +1,122 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestUnitSumologicCloudToCloudTypedSources(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := func(oktaDomain string) string {
+		return fmt.Sprintf(`
+resource "sumologic_collector" "test" {
+	name = "collector"
+}
+
+resource "sumologic_okta_source" "test" {
+	collector_id = sumologic_collector.test.id
+	name = "okta"
+	category = "okta"
+	domain = "%s"
+	api_key = "okta-secret"
+	fields = {
+		_siemForward = "false"
+	}
+}
+
+resource "sumologic_crowdstrike_source" "test" {
+	collector_id = sumologic_collector.test.id
+	name = "crowdstrike"
+	domain = "api.eu-1.crowdstrike.com"
+	client_id = "client"
+	client_secret = "crowdstrike-secret"
+	polling_interval = 600
+}
+
+resource "sumologic_netskope_source" "test" {
+	collector_id = sumologic_collector.test.id
+	name = "netskope"
+	endpoint = "example.goskope.com"
+	api_token = "netskope-secret"
+	event_types = ["audit", "page"]
+}`, oktaDomain)
+	}
+	sourcePath := func(attributes map[string]string) string {
+		return fmt.Sprintf("v1/collectors/%s/sources/%s", attributes["collector_id"], attributes["id"])
+	}
+	// The sources are created concurrently, so their IDs are looked up.
+	var oktaPath string
+	importStep := func(name, secret string) resource.TestStep {
+		return resource.TestStep{
+			ResourceName:            name,
+			ImportState:             true,
+			ImportStateIdFunc:       testImportStateIdFunc(name, "collector_id", "id"),
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{secret},
+		}
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config:      config("https://demo.okta.com"),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("must be a host name without a scheme or path"),
+		},
+		resource.TestStep{
+			Config: config("demo.okta.com"),
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_okta_source.test", sourcePath, map[string]interface{}{
+					"sourceType": "Universal", "schemaRef": map[string]interface{}{"type": "Okta"},
+					"config": map[string]interface{}{
+						"name": "okta", "category": "okta", "domain": "demo.okta.com", "apiKey": "okta-secret",
+						"collectAll": true, "pollingInterval": float64(300),
+						"fields": map[string]interface{}{"_siemForward": "false"},
+					},
+				}),
+				api.checkObject("sumologic_crowdstrike_source.test", sourcePath, map[string]interface{}{
+					"schemaRef": map[string]interface{}{"type": "CrowdStrike"},
+					"config": map[string]interface{}{
+						"name": "crowdstrike", "domain": "api.eu-1.crowdstrike.com", "clientId": "client",
+						"clientSecret": "crowdstrike-secret", "pollingInterval": float64(600),
+						"fields": map[string]interface{}{},
+					},
+				}),
+				resource.TestCheckResourceAttr("sumologic_netskope_source.test", "event_types.1", "page"),
+				func(s *terraform.State) error {
+					oktaPath = sourcePath(s.RootModule().Resources["sumologic_okta_source.test"].Primary.Attributes)
+					return nil
+				},
+			),
+		},
+		importStep("sumologic_okta_source.test", "api_key"),
+		importStep("sumologic_crowdstrike_source.test", "client_secret"),
+		importStep("sumologic_netskope_source.test", "api_token"),
+		resource.TestStep{
+			PreConfig: func() {
+				api.update(oktaPath, func(source map[string]interface{}) {
+					source["config"].(map[string]interface{})["apiKey"] = "********"
+				})
+			},
+			Config:   config("demo.okta.com"),
+			PlanOnly: true,
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update(oktaPath, func(source map[string]interface{}) {
+					source["config"].(map[string]interface{})["collectAll"] = false
+				})
+			},
+			Config:             config("demo.okta.com"),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+		resource.TestStep{
+			Config: config("demo.okta.com"),
+			Check:  resource.TestCheckResourceAttr("sumologic_okta_source.test", "api_key", "okta-secret"),
+		},
+	)
+}
@@ -48,6 +48,10 @@ func resourceSumologicCollector() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -86,6 +90,7 @@ func resourceSumologicCollectorRead(d *schema.ResourceData, meta interface{}) er
 	if err := d.Set("fields", collector.Fields); err != nil {
 		return fmt.Errorf("error setting fields for resource %s: %s", d.Id(), err)
 	}
+	d.Set("etag", collector.ETag)
 
 	return nil
 }
@@ -145,5 +150,6 @@ func resourceToCollector(d *schema.ResourceData) (Collector, error) {
 		Category:      d.Get("category").(string),
 		TimeZone:      d.Get("timezone").(string),
 		Fields:        d.Get("fields").(map[string]interface{}),
+		ETag:          d.Get("etag").(string),
 	}, nil
 }
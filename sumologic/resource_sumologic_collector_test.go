@@ -212,3 +212,49 @@ resource "sumologic_collector" "test" {
 	timezone = "Europe/Berlin"
 }`, name, description, category)
 }
+
+func TestUnitSumologicCollector(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	collectorPath := func(attributes map[string]string) string {
+		return "v1/collectors/" + attributes["id"]
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: testAccSumologicCollectorConfig("MyCollector", "MyDescription", "Cat"),
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_collector.test", collectorPath, map[string]interface{}{
+					"name": "MyCollector", "description": "MyDescription", "category": "Cat", "timeZone": "Etc/UTC",
+				}),
+				resource.TestCheckResourceAttrSet("sumologic_collector.test", "etag"),
+			),
+		},
+		resource.TestStep{
+			Config: testAccSumologicCollectorConfigUpdate("MyCollector", "MyOtherDescription", "Cat"),
+			Check: api.checkObject("sumologic_collector.test", collectorPath, map[string]interface{}{
+				"description": "MyOtherDescription", "timeZone": "Europe/Berlin",
+			}),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_collector.test",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update("v1/collectors/1", func(collector map[string]interface{}) {
+					collector["description"] = "Changed in the UI"
+				})
+			},
+			Config:             testAccSumologicCollectorConfigUpdate("MyCollector", "MyOtherDescription", "Cat"),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+		resource.TestStep{
+			Config: testAccSumologicCollectorConfigUpdate("MyCollector", "MyOtherDescription", "Cat"),
+			Check: api.checkObject("sumologic_collector.test", collectorPath, map[string]interface{}{
+				"description": "MyOtherDescription",
+			}),
+		},
+	)
+}
@@ -40,7 +40,8 @@ func resourceSumologicContent() *schema.Resource {
 }
 
 func resourceSumologicContentRead(d *schema.ResourceData, meta interface{}) error {
-	c := meta.(*Client)
+	c, cancel := meta.(*Client).withTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
 	// Retrieve the content Id from the state
 	id := d.Id()
 	log.Printf("[DEBUG] Looking for content with id: %s", id)
@@ -65,13 +66,15 @@ func resourceSumologicContentRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceSumologicContentDelete(d *schema.ResourceData, meta interface{}) error {
-	c := meta.(*Client)
+	c, cancel := meta.(*Client).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	log.Printf("Deleting content with id: %s", d.Id())
 	return c.DeleteContent(d.Id(), d.Timeout(schema.TimeoutDelete))
 }
 
 func resourceSumologicContentCreate(d *schema.ResourceData, meta interface{}) error {
-	c := meta.(*Client)
+	c, cancel := meta.(*Client).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	// If there is no id in the state, then we need to create the object
 	if d.Id() == "" {
@@ -91,7 +94,8 @@ func resourceSumologicContentCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceSumologicContentUpdate(d *schema.ResourceData, meta interface{}) error {
-	c := meta.(*Client)
+	c, cancel := meta.(*Client).withTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 
 	content := resourceToContent(d)
 
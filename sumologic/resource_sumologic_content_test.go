@@ -188,3 +188,35 @@ JSON
 }
 `, configJson)
 }
+
+func TestUnitSumologicContent(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	contentPath := func(attributes map[string]string) string {
+		return "v2/content/" + attributes["id"]
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: testAccSumologicContent(configJson),
+			Check: api.checkObject("sumologic_content.test", contentPath, map[string]interface{}{
+				"type": "SavedSearchWithScheduleSyncDefinition", "parentId": fakePersonalFolderID,
+			}),
+		},
+		resource.TestStep{
+			Config: testAccSumologicContent(updateConfigJson),
+			Check: api.checkObject("sumologic_content.test", contentPath, map[string]interface{}{
+				"description": "Runs every hour with timerange of 15m and sends email notifications updated",
+			}),
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update("v2/content/0000000000000001", func(content map[string]interface{}) {
+					content["description"] = "Changed in the UI"
+				})
+			},
+			Config:             testAccSumologicContent(updateConfigJson),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+	)
+}
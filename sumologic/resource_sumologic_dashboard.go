@@ -1162,7 +1162,7 @@ func makeTerraformObject() TerraformObject {
 
 func getTerraformTopologyLabel(topologyLabel *TopologyLabel) []map[string]interface{} {
 	// API returns an empty data map if we don't set topologyLabelMap.
-	if len(topologyLabel.Data) == 0 {
+	if topologyLabel == nil || len(topologyLabel.Data) == 0 {
 		return nil
 	}
 
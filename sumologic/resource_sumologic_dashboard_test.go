@@ -567,3 +567,34 @@ func dashboardUpdateConfig(title string, description string, theme string, refre
 		variables[1].Name, variables[1].DisplayName, loqQuerySourceDef.Query, loqQuerySourceDef.Field,
 	)
 }
+
+func TestUnitSumologicDashboard(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	dashboardPath := func(attributes map[string]string) string {
+		return "v2/dashboards/" + attributes["id"]
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: dashboardImportConfig("MyDashboard"),
+			Check: api.checkObject("sumologic_dashboard.tf_import_test", dashboardPath, map[string]interface{}{
+				"title": "MyDashboard", "folderId": fakePersonalFolderID,
+			}),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_dashboard.tf_import_test",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update("v2/dashboards/0000000000000001", func(dashboard map[string]interface{}) {
+					dashboard["title"] = "Renamed in the UI"
+				})
+			},
+			Config:             dashboardImportConfig("MyDashboard"),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+	)
+}
@@ -0,0 +1,125 @@
+package sumologic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceSumologicDockerLogSource() *schema.Resource {
+	dockerLogSource := resourceSumologicSource()
+	dockerLogSource.Create = resourceSumologicDockerLogSourceCreate
+	dockerLogSource.Read = resourceSumologicDockerLogSourceRead
+	dockerLogSource.Update = resourceSumologicDockerLogSourceUpdate
+	dockerLogSource.Importer = &schema.ResourceImporter{
+		State: resourceSumologicSourceImport,
+	}
+
+	dockerLogSource.Schema["uri"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	dockerLogSource.Schema["specified_containers"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	dockerLogSource.Schema["all_containers"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+	dockerLogSource.Schema["cert_path"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	dockerLogSource.Schema["collect_events"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+
+	return dockerLogSource
+}
+
+func resourceSumologicDockerLogSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	if d.Id() == "" {
+		source := resourceToDockerLogSource(d)
+
+		id, err := c.CreateDockerLogSource(source, d.Get("collector_id").(int))
+
+		if err != nil {
+			return err
+		}
+
+		d.SetId(strconv.Itoa(id))
+	}
+
+	return resourceSumologicDockerLogSourceRead(d, meta)
+}
+
+func resourceSumologicDockerLogSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	source := resourceToDockerLogSource(d)
+
+	err := c.UpdateDockerLogSource(source, d.Get("collector_id").(int))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceSumologicDockerLogSourceRead(d, meta)
+}
+
+func resourceToDockerLogSource(d *schema.ResourceData) DockerLogSource {
+	source := resourceToSource(d)
+	source.Type = "DockerLog"
+
+	dockerLogSource := DockerLogSource{
+		Source:              source,
+		URI:                 d.Get("uri").(string),
+		SpecifiedContainers: getStringList(d, "specified_containers"),
+		AllContainers:       d.Get("all_containers").(bool),
+		CertPath:            d.Get("cert_path").(string),
+		CollectEvents:       d.Get("collect_events").(bool),
+	}
+
+	return dockerLogSource
+}
+
+func resourceSumologicDockerLogSourceRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id, _ := strconv.Atoi(d.Id())
+	source, err := c.GetDockerLogSource(d.Get("collector_id").(int), id)
+	if err != nil {
+		return err
+	}
+
+	if source == nil {
+		log.Printf("[WARN] Docker log source not found, removing from state: %v - %v", id, err)
+		d.SetId("")
+
+		return nil
+	}
+
+	if err := resourceSumologicSourceRead(d, source.Source); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	d.Set("uri", source.URI)
+	if err := d.Set("specified_containers", source.SpecifiedContainers); err != nil {
+		return fmt.Errorf("error setting specified containers for resource %s: %s", d.Id(), err)
+	}
+	d.Set("all_containers", source.AllContainers)
+	d.Set("cert_path", source.CertPath)
+	d.Set("collect_events", source.CollectEvents)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitSumologicDockerLogSource(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	api.seed("v1/collectors/42", map[string]interface{}{
+		"id": 42, "name": "host-collector", "collectorType": "Installable",
+	})
+	config := `
+resource "sumologic_docker_log_source" "test" {
+	collector_id = 42
+	name = "docker"
+	uri = "unix:///var/run/docker.sock"
+	all_containers = false
+	specified_containers = ["web", "db"]
+}`
+	sourcePath := func(attributes map[string]string) string {
+		return fmt.Sprintf("v1/collectors/%s/sources/%s", attributes["collector_id"], attributes["id"])
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: config,
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_docker_log_source.test", sourcePath, map[string]interface{}{
+					"sourceType": "DockerLog", "allContainers": false, "uri": "unix:///var/run/docker.sock",
+				}),
+				resource.TestCheckResourceAttr("sumologic_docker_log_source.test", "specified_containers.1", "db"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_docker_log_source.test",
+			ImportState:       true,
+			ImportStateIdFunc: testImportStateIdFunc("sumologic_docker_log_source.test", "collector_id", "id"),
+			ImportStateVerify: true,
+		},
+	)
+}
@@ -66,7 +66,8 @@ func resourceSumologicFolderRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSumologicFolderDelete(d *schema.ResourceData, meta interface{}) error {
-	c := meta.(*Client)
+	c, cancel := meta.(*Client).withTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	log.Printf("[DEBUG] Deleting folder: %s", d.Id())
 	return c.DeleteFolder(d.Id(), d.Timeout(schema.TimeoutDelete))
 }
@@ -127,3 +127,34 @@ resource "sumologic_folder" "test" {
 }
 `, name)
 }
+
+func TestUnitSumologicFolder(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	folderPath := func(attributes map[string]string) string {
+		return "v2/content/" + attributes["id"]
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: testAccSumologicFolder("MyFolder"),
+			Check: api.checkObject("sumologic_folder.test", folderPath, map[string]interface{}{
+				"name": "MyFolder", "description": "test", "parentId": fakePersonalFolderID,
+			}),
+		},
+		resource.TestStep{
+			Config: testAccSumologicFolderUpdate("MyFolder"),
+			Check: api.checkObject("sumologic_folder.test", folderPath, map[string]interface{}{
+				"description": "Update test",
+			}),
+		},
+		resource.TestStep{
+			PreConfig:          func() { api.remove("v2/content/0000000000000001") },
+			Config:             testAccSumologicFolderUpdate("MyFolder"),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+		resource.TestStep{
+			Config: testAccSumologicFolderUpdate("MyFolder"),
+		},
+	)
+}
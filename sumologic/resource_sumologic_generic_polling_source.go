@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -18,6 +20,10 @@ func resourceSumologicGenericPollingSource() *schema.Resource {
 	pollingSource.Importer = &schema.ResourceImporter{
 		State: resourceSumologicSourceImport,
 	}
+	pollingSource.CustomizeDiff = customdiff.All(
+		pollingSource.CustomizeDiff,
+		resourceSumologicGenericPollingSourceCustomizeDiff,
+	)
 
 	pollingSource.Schema["content_type"] = &schema.Schema{
 		Type:     schema.TypeString,
@@ -26,16 +32,21 @@ func resourceSumologicGenericPollingSource() *schema.Resource {
 		ValidateFunc: validation.StringInSlice([]string{"AwsS3Bucket", "AwsElbBucket", "AwsCloudFrontBucket",
 			"AwsCloudTrailBucket", "AwsS3AuditBucket", "AwsCloudWatch", "AwsInventory", "AwsXRay"}, false),
 	}
-	pollingSource.Schema["scan_interval"] = &schema.Schema{
-		Type:     schema.TypeInt,
-		Required: true,
+	pollingSource.Schema["scan_interval"] = sourceScanIntervalSchema()
+	pollingSource.Schema["paused"] = sourcePausedSchema()
+	pollingSource.Schema["url"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
 	}
-	pollingSource.Schema["paused"] = &schema.Schema{
+	// The defaults depend on the content type, see pollingLineDefaults.
+	pollingSource.Schema["multiline_processing_enabled"] = &schema.Schema{
 		Type:     schema.TypeBool,
-		Required: true,
+		Optional: true,
+		Computed: true,
 	}
-	pollingSource.Schema["url"] = &schema.Schema{
-		Type:     schema.TypeString,
+	pollingSource.Schema["use_autoline_matching"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
 		Computed: true,
 	}
 	pollingSource.Schema["authentication"] = &schema.Schema{
@@ -85,8 +96,30 @@ func resourceSumologicGenericPollingSource() *schema.Resource {
 					Optional: true,
 				},
 				"path_expression": {
-					Type:     schema.TypeString,
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateS3PathExpression,
+				},
+				"use_versioned_api": {
+					Type:     schema.TypeBool,
 					Optional: true,
+					Default:  true,
+				},
+				"sns_topic_or_subscription_arn": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"is_success": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"arn": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
 				},
 				"limit_to_regions": {
 					Type:     schema.TypeList,
@@ -102,7 +135,13 @@ func resourceSumologicGenericPollingSource() *schema.Resource {
 						Type: schema.TypeString,
 					},
 				},
-
+				"limit_to_services": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
 				"tag_filters": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -216,6 +255,14 @@ func resourceToGenericPollingSource(d *schema.ResourceData) (PollingSource, erro
 		URL:          d.Get("url").(string),
 	}
 
+	contentType := d.Get("content_type").(string)
+	if _, ok := d.GetOkExists("multiline_processing_enabled"); !ok {
+		pollingSource.MultilineProcessingEnabled = pollingLineDefaults[contentType]
+	}
+	if _, ok := d.GetOkExists("use_autoline_matching"); !ok {
+		pollingSource.UseAutolineMatching = pollingLineDefaults[contentType]
+	}
+
 	authSettings, errAuthSettings := getPollingAuthentication(d)
 	if errAuthSettings != nil {
 		return pollingSource, errAuthSettings
@@ -248,7 +295,16 @@ func getPollingThirdPartyPathAttributes(pollingResource []PollingResource) []map
 			"path_expression":     t.Path.PathExpression,
 			"limit_to_regions":    t.Path.LimitToRegions,
 			"limit_to_namespaces": t.Path.LimitToNamespaces,
+			"limit_to_services":   t.Path.LimitToServices,
 			"tag_filters":         flattenPollingTagFilters(t.Path.TagFilters),
+			// The API leaves out useVersionedApi unless it is false.
+			"use_versioned_api": t.Path.UseVersionedApi == nil || *t.Path.UseVersionedApi,
+		}
+		if arn := t.Path.SnsTopicOrSubscriptionArn; arn != nil {
+			mapping["sns_topic_or_subscription_arn"] = []map[string]interface{}{{
+				"is_success": arn.IsSuccess,
+				"arn":        arn.Arn,
+			}}
 		}
 		s = append(s, mapping)
 	}
@@ -332,6 +388,8 @@ func getPollingPathSettings(d *schema.ResourceData) (PollingPath, error) {
 			pathSettings.Type = "S3BucketPathExpression"
 			pathSettings.BucketName = path["bucket_name"].(string)
 			pathSettings.PathExpression = path["path_expression"].(string)
+			useVersionedApi := path["use_versioned_api"].(bool)
+			pathSettings.UseVersionedApi = &useVersionedApi
 		case "CloudWatchPath", "AwsInventoryPath":
 			pathSettings.Type = pathType
 			rawLimitToRegions := path["limit_to_regions"].([]interface{})
@@ -353,6 +411,14 @@ func getPollingPathSettings(d *schema.ResourceData) (PollingPath, error) {
 			pathSettings.LimitToNamespaces = LimitToNamespaces
 			if pathType == "CloudWatchPath" {
 				pathSettings.TagFilters = getPollingTagFilters(d)
+				rawLimitToServices := path["limit_to_services"].([]interface{})
+				LimitToServices := make([]string, 0, len(rawLimitToServices))
+				for _, v := range rawLimitToServices {
+					if v != nil {
+						LimitToServices = append(LimitToServices, v.(string))
+					}
+				}
+				pathSettings.LimitToServices = LimitToServices
 			}
 		case "AwsXRayPath":
 			pathSettings.Type = "AwsXRayPath"
@@ -373,3 +439,122 @@ func getPollingPathSettings(d *schema.ResourceData) (PollingPath, error) {
 
 	return pathSettings, nil
 }
+
+// pollingLineDefaults holds the defaults of multiline_processing_enabled and
+// use_autoline_matching per content type. Only generic S3 buckets may hold
+// multiline logs; the other content types are single line logs or metrics.
+var pollingLineDefaults = map[string]bool{
+	"AwsS3Bucket":         true,
+	"AwsElbBucket":        false,
+	"AwsCloudFrontBucket": false,
+	"AwsCloudTrailBucket": false,
+	"AwsS3AuditBucket":    false,
+	"AwsCloudWatch":       false,
+	"AwsInventory":        false,
+	"AwsXRay":             false,
+}
+
+// pollingPathTypes maps each content type to the path type it is polled with.
+var pollingPathTypes = map[string]string{
+	"AwsS3Bucket":         "S3BucketPathExpression",
+	"AwsElbBucket":        "S3BucketPathExpression",
+	"AwsCloudFrontBucket": "S3BucketPathExpression",
+	"AwsCloudTrailBucket": "S3BucketPathExpression",
+	"AwsS3AuditBucket":    "S3BucketPathExpression",
+	"AwsCloudWatch":       "CloudWatchPath",
+	"AwsInventory":        "AwsInventoryPath",
+	"AwsXRay":             "AwsXRayPath",
+}
+
+// pollingPathFields lists the list attributes of path each path type accepts.
+var pollingPathFields = map[string]map[string]bool{
+	"S3BucketPathExpression": {},
+	"CloudWatchPath": {
+		"limit_to_regions": true, "limit_to_namespaces": true, "limit_to_services": true, "tag_filters": true,
+	},
+	"AwsInventoryPath": {"limit_to_regions": true, "limit_to_namespaces": true},
+	"AwsXRayPath":      {"limit_to_regions": true},
+}
+
+// validateS3PathExpression checks that a path expression is relative to the
+// bucket. Only * is a wildcard, other glob characters are matched literally.
+func validateS3PathExpression(i interface{}, k string) ([]string, []error) {
+	expr, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if strings.HasPrefix(strings.ToLower(expr), "s3://") {
+		return nil, []error{fmt.Errorf("%s: %q must not include the s3:// scheme and the bucket name", k, expr)}
+	}
+	if strings.HasPrefix(expr, "/") {
+		return nil, []error{fmt.Errorf("%s: %q must not start with /, object keys are relative to the bucket", k, expr)}
+	}
+	if strings.ContainsAny(expr, "?[]{}") {
+		return []string{fmt.Sprintf("%s: %q contains ?, [, ], { or }, which are matched literally; only * is a wildcard",
+			k, expr)}, nil
+	}
+	return nil, nil
+}
+
+// resourceSumologicGenericPollingSourceCustomizeDiff rejects settings the
+// content type does not support at plan time.
+func resourceSumologicGenericPollingSourceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("content_type") || !d.NewValueKnown("path.0.type") {
+		return nil
+	}
+	contentType := d.Get("content_type").(string)
+	paths := d.Get("path").([]interface{})
+	if len(paths) == 0 || paths[0] == nil {
+		return nil
+	}
+	path := paths[0].(map[string]interface{})
+	pathType := path["type"].(string)
+
+	if expected := pollingPathTypes[contentType]; pathType != expected {
+		return fmt.Errorf("path type %s is not supported for content type %s, use %s", pathType, contentType, expected)
+	}
+
+	for _, field := range []string{"limit_to_regions", "limit_to_namespaces", "limit_to_services", "tag_filters"} {
+		if !d.NewValueKnown("path.0."+field) || len(path[field].([]interface{})) == 0 {
+			continue
+		}
+		if !pollingPathFields[pathType][field] {
+			return fmt.Errorf("path.0.%s is not supported for content type %s", field, contentType)
+		}
+	}
+	if pathType == "S3BucketPathExpression" {
+		for _, field := range []string{"bucket_name", "path_expression"} {
+			if d.NewValueKnown("path.0."+field) && path[field].(string) == "" {
+				return fmt.Errorf("path.0.%s must be set for content type %s", field, contentType)
+			}
+		}
+	} else {
+		for _, field := range []string{"bucket_name", "path_expression"} {
+			if d.NewValueKnown("path.0."+field) && path[field].(string) != "" {
+				return fmt.Errorf("path.0.%s is not supported for content type %s", field, contentType)
+			}
+		}
+		if !path["use_versioned_api"].(bool) {
+			return fmt.Errorf("path.0.use_versioned_api is not supported for content type %s", contentType)
+		}
+	}
+
+	if auths := d.Get("authentication").([]interface{}); contentType == "AwsInventory" && len(auths) > 0 && auths[0] != nil {
+		if authType := auths[0].(map[string]interface{})["type"].(string); authType == "S3BucketAuthentication" {
+			return fmt.Errorf("authentication type %s is not supported for content type %s, use AWSRoleBasedAuthentication",
+				authType, contentType)
+		}
+	}
+
+	// Metrics are polled as they are published, there is no backlog to cut
+	// off.
+	if pathType != "S3BucketPathExpression" {
+		if d.NewValueKnown("cutoff_timestamp") && d.Get("cutoff_timestamp").(int) != 0 {
+			return fmt.Errorf("cutoff_timestamp is not supported for content type %s", contentType)
+		}
+		if d.NewValueKnown("cutoff_relative_time") && d.Get("cutoff_relative_time").(string) != "" {
+			return fmt.Errorf("cutoff_relative_time is not supported for content type %s", contentType)
+		}
+	}
+	return nil
+}
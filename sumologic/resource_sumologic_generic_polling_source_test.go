@@ -0,0 +1,140 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestUnitSumologicPollingSources(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := func(s3Path, cloudWatchPath, cloudWatchExtra string) string {
+		return fmt.Sprintf(`
+resource "sumologic_collector" "test" {
+	name = "collector"
+}
+
+resource "sumologic_s3_source" "test" {
+	collector_id = sumologic_collector.test.id
+	name = "s3"
+	content_type = "AwsS3Bucket"
+	scan_interval = 300000
+	paused = false
+	authentication {
+		type = "AWSRoleBasedAuthentication"
+		role_arn = "arn:aws:iam::123456789012:role/sumo"
+	}
+	path {
+		%s
+	}
+}
+
+resource "sumologic_cloudwatch_source" "test" {
+	collector_id = sumologic_collector.test.id
+	name = "cloudwatch"
+	content_type = "AwsCloudWatch"
+	scan_interval = 300000
+	paused = false
+	%s
+	authentication {
+		type = "AWSRoleBasedAuthentication"
+		role_arn = "arn:aws:iam::123456789012:role/sumo"
+	}
+	path {
+		%s
+	}
+}`, s3Path, cloudWatchExtra, cloudWatchPath)
+	}
+	s3Path := `type = "S3BucketPathExpression"
+		bucket_name = "logs"
+		path_expression = "app/*"`
+	cloudWatchPath := `type = "CloudWatchPath"
+		limit_to_regions = ["us-east-1"]
+		limit_to_services = ["EC2", "RDS"]`
+	sourcePath := func(attributes map[string]string) string {
+		return fmt.Sprintf("v1/collectors/%s/sources/%s", attributes["collector_id"], attributes["id"])
+	}
+	var s3SourcePath string
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config:      config(s3Path, s3Path, ""),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("path type S3BucketPathExpression is not supported for content type AwsCloudWatch"),
+		},
+		resource.TestStep{
+			Config:      config(s3Path+"\n\t\tlimit_to_namespaces = [\"AWS/EC2\"]", cloudWatchPath, ""),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("path.0.limit_to_namespaces is not supported for content type AwsS3Bucket"),
+		},
+		resource.TestStep{
+			Config:      config(`type = "S3BucketPathExpression"`+"\n\t\tpath_expression = \"app/*\"", cloudWatchPath, ""),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("path.0.bucket_name must be set for content type AwsS3Bucket"),
+		},
+		resource.TestStep{
+			Config:      config(strings.Replace(s3Path, `"app/*"`, `"/app/*"`, 1), cloudWatchPath, ""),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("must not start with /"),
+		},
+		resource.TestStep{
+			Config:      config(s3Path, cloudWatchPath, `cutoff_relative_time = "-1h"`),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("cutoff_relative_time is not supported for content type AwsCloudWatch"),
+		},
+		resource.TestStep{
+			Config:      strings.Replace(config(s3Path, cloudWatchPath, ""), "scan_interval = 300000", "scan_interval = 500", 1),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`expected scan_interval to be in the range \(1000 - 86400000\), got 500`),
+		},
+		resource.TestStep{
+			Config: config(s3Path, cloudWatchPath, ""),
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_s3_source.test", sourcePath, map[string]interface{}{
+					"sourceType": "Polling", "contentType": "AwsS3Bucket",
+					"multilineProcessingEnabled": true, "useAutolineMatching": true,
+				}),
+				api.checkObject("sumologic_cloudwatch_source.test", sourcePath, map[string]interface{}{
+					"sourceType": "Polling", "contentType": "AwsCloudWatch",
+					"multilineProcessingEnabled": false, "useAutolineMatching": false,
+				}),
+				resource.TestCheckResourceAttr("sumologic_s3_source.test", "path.0.use_versioned_api", "true"),
+				resource.TestCheckResourceAttr("sumologic_s3_source.test", "path.0.sns_topic_or_subscription_arn.#", "0"),
+				resource.TestCheckResourceAttr("sumologic_cloudwatch_source.test", "path.0.limit_to_services.1", "RDS"),
+				func(s *terraform.State) error {
+					s3SourcePath = sourcePath(s.RootModule().Resources["sumologic_s3_source.test"].Primary.Attributes)
+					return nil
+				},
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_cloudwatch_source.test",
+			ImportState:       true,
+			ImportStateIdFunc: testImportStateIdFunc("sumologic_cloudwatch_source.test", "collector_id", "id"),
+			ImportStateVerify: true,
+			// The credentials are not read back.
+			ImportStateVerifyIgnore: []string{"authentication"},
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update(s3SourcePath, func(source map[string]interface{}) {
+					resources := source["thirdPartyRef"].(map[string]interface{})["resources"].([]interface{})
+					path := resources[0].(map[string]interface{})["path"].(map[string]interface{})
+					path["snsTopicOrSubscriptionArn"] = map[string]interface{}{
+						"isSuccess": true, "arn": "arn:aws:sns:us-east-1:123456789012:logs",
+					}
+				})
+			},
+			Config: config(s3Path, cloudWatchPath, ""),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("sumologic_s3_source.test", "path.0.sns_topic_or_subscription_arn.0.is_success", "true"),
+				resource.TestCheckResourceAttr("sumologic_s3_source.test", "path.0.sns_topic_or_subscription_arn.0.arn",
+					"arn:aws:sns:us-east-1:123456789012:logs"),
+			),
+		},
+	)
+}
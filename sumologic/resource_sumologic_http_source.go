@@ -5,7 +5,9 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func resourceSumologicHTTPSource() *schema.Resource {
@@ -16,16 +18,43 @@ func resourceSumologicHTTPSource() *schema.Resource {
 	httpSource.Importer = &schema.ResourceImporter{
 		State: resourceSumologicSourceImport,
 	}
+	httpSource.CustomizeDiff = customdiff.All(
+		httpSource.CustomizeDiff,
+		resourceSumologicHTTPSourceCustomizeDiff,
+	)
 
+	httpSource.Schema["content_type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: validation.StringInSlice([]string{"Zipkin", "Otlp", "Prometheus", "Graphite", "Carbon2",
+			"KinesisLog"}, false),
+	}
 	httpSource.Schema["message_per_request"] = &schema.Schema{
 		Type:     schema.TypeBool,
 		Optional: true,
 		Default:  false,
 	}
+	httpSource.Schema["paused"] = sourcePausedSchema()
+	httpSource.Schema["auth_method"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "url",
+		ValidateFunc: validation.StringInSlice([]string{"url", "token"}, false),
+	}
+	httpSource.Schema["rotation_trigger"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
 	httpSource.Schema["url"] = &schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
 	}
+	httpSource.Schema["token"] = &schema.Schema{
+		Type:      schema.TypeString,
+		Computed:  true,
+		Sensitive: true,
+	}
 
 	return httpSource
 }
@@ -59,9 +88,53 @@ func resourceSumologicHTTPSourceUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	// The new URL is generated last, as it changes the ETag.
+	if d.HasChange("rotation_trigger") {
+		log.Printf("[DEBUG] Regenerating the URL of HTTP source %d", source.ID)
+		if err := c.RegenerateHTTPSourceURL(d.Get("collector_id").(int), source.ID); err != nil {
+			return err
+		}
+	}
+
 	return resourceSumologicHTTPSourceRead(d, meta)
 }
 
+// httpSourceLogContentTypes are the content types of sources receiving logs,
+// the others receive metrics or traces.
+var httpSourceLogContentTypes = map[string]bool{
+	"":           true,
+	"KinesisLog": true,
+}
+
+// resourceSumologicHTTPSourceCustomizeDiff rejects settings the content type
+// does not support at plan time.
+func resourceSumologicHTTPSourceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// A new trigger regenerates the URL and token on apply.
+	if d.Id() != "" && d.HasChange("rotation_trigger") {
+		if err := d.SetNewComputed("url"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("token"); err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown("content_type") {
+		return nil
+	}
+	contentType := d.Get("content_type").(string)
+
+	if d.Get("message_per_request").(bool) && !httpSourceLogContentTypes[contentType] {
+		return fmt.Errorf("message_per_request is not supported for content type %s, it only applies to logs",
+			contentType)
+	}
+	// Kinesis Data Firehose cannot send the token header.
+	if d.Get("auth_method").(string) == "token" && contentType == "KinesisLog" {
+		return fmt.Errorf("auth_method token is not supported for content type %s", contentType)
+	}
+	return nil
+}
+
 func resourceToHTTPSource(d *schema.ResourceData) HTTPSource {
 	source := resourceToSource(d)
 	source.Type = "HTTP"
@@ -69,6 +142,8 @@ func resourceToHTTPSource(d *schema.ResourceData) HTTPSource {
 	httpSource := HTTPSource{
 		Source:            source,
 		MessagePerRequest: d.Get("message_per_request").(bool),
+		Paused:            d.Get("paused").(bool),
+		AuthMethod:        d.Get("auth_method").(string),
 	}
 
 	return httpSource
@@ -95,7 +170,15 @@ func resourceSumologicHTTPSourceRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("%s", err)
 	}
 	d.Set("message_per_request", source.MessagePerRequest)
+	d.Set("paused", source.Paused)
+	// Sources created before token authentication existed have no method.
+	if source.AuthMethod == "" {
+		d.Set("auth_method", "url")
+	} else {
+		d.Set("auth_method", source.AuthMethod)
+	}
 	d.Set("url", source.URL)
+	d.Set("token", source.Token)
 
 	return nil
 }
@@ -2,6 +2,7 @@ package sumologic
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -258,3 +259,162 @@ resource "sumologic_http_source" "kinesisLog" {
 }
 `, cName, cDescription, cCategory, sName, sDescription, sCategory, tName, tDescription, tCategory, kName, kDescription, kCategory)
 }
+
+func TestUnitSumologicHTTPSource(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := testAccSumologicHTTPSourceConfig("Collector", "", "", "Http", "Logs", "cat",
+		"Traces", "", "", "Kinesis", "", "")
+	sourcePath := func(attributes map[string]string) string {
+		return fmt.Sprintf("v1/collectors/%s/sources/%s", attributes["collector_id"], attributes["id"])
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: config,
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_http_source.http", sourcePath, map[string]interface{}{
+					"name": "Http", "description": "Logs", "category": "cat", "sourceType": "HTTP",
+				}),
+				api.checkObject("sumologic_http_source.traces", sourcePath, map[string]interface{}{
+					"name": "Traces", "contentType": "Zipkin",
+				}),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_http_source.http",
+			ImportState:       true,
+			ImportStateIdFunc: testImportStateIdFunc("sumologic_http_source.http", "collector_id", "id"),
+			ImportStateVerify: true,
+		},
+		resource.TestStep{
+			PreConfig:          func() { api.remove("v1/collectors/1/sources/2") },
+			Config:             config,
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+		resource.TestStep{
+			Config: config,
+		},
+	)
+}
+
+func TestUnitSumologicHTTPSourceModes(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := func(logsSettings, tracesSettings string) string {
+		return fmt.Sprintf(`
+resource "sumologic_collector" "test" {
+	name = "collector"
+}
+
+resource "sumologic_http_source" "logs" {
+	name = "logs"
+	collector_id = sumologic_collector.test.id
+	%s
+}
+
+resource "sumologic_http_source" "traces" {
+	name = "traces"
+	collector_id = sumologic_collector.test.id
+	%s
+}
+
+resource "sumologic_collector" "consumer" {
+	name = "consumer"
+	description = sumologic_http_source.traces.url
+}`, logsSettings, tracesSettings)
+	}
+	sourcePath := func(attributes map[string]string) string {
+		return fmt.Sprintf("v1/collectors/%s/sources/%s", attributes["collector_id"], attributes["id"])
+	}
+	tokenSettings := func(trigger string) string {
+		return fmt.Sprintf("auth_method = \"token\"\n\tpaused = true\n\trotation_trigger = \"%s\"", trigger)
+	}
+	var previousToken, tracesID, tracesURL string
+	tokenRotated := func(s *terraform.State) error {
+		token := s.RootModule().Resources["sumologic_http_source.logs"].Primary.Attributes["token"]
+		if token == "" || token == previousToken {
+			return fmt.Errorf("expected a new token, got %q", token)
+		}
+		previousToken = token
+		return nil
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config:      config("", "content_type = \"Statsd\""),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("expected content_type to be one of"),
+		},
+		resource.TestStep{
+			Config:      config("", "content_type = \"Prometheus\"\n\tmessage_per_request = true"),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("message_per_request is not supported for content type Prometheus"),
+		},
+		resource.TestStep{
+			Config:      config("content_type = \"KinesisLog\"\n\tauth_method = \"token\"", "content_type = \"Otlp\""),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("auth_method token is not supported for content type KinesisLog"),
+		},
+		resource.TestStep{
+			Config: config("auth_method = \"token\"\n\tpaused = true", "content_type = \"Otlp\""),
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_http_source.logs", sourcePath, map[string]interface{}{
+					"authMethod": "token", "paused": true,
+				}),
+				api.checkObject("sumologic_http_source.traces", sourcePath, map[string]interface{}{
+					"contentType": "Otlp", "paused": false,
+				}),
+				resource.TestCheckResourceAttr("sumologic_http_source.traces", "auth_method", "url"),
+			),
+		},
+		resource.TestStep{
+			Config: config(tokenSettings("2021-10"), "content_type = \"Otlp\""),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("sumologic_http_source.logs", "url", "https://collectors.sumologic.com/receiver/v1/http"),
+				tokenRotated,
+			),
+		},
+		resource.TestStep{
+			Config: config(tokenSettings("2021-11"), "content_type = \"Otlp\"\n\tpaused = true"),
+			Check: resource.ComposeTestCheckFunc(
+				tokenRotated,
+				api.checkObject("sumologic_http_source.traces", sourcePath, map[string]interface{}{"paused": true}),
+				func(s *terraform.State) error {
+					tracesID = s.RootModule().Resources["sumologic_http_source.traces"].Primary.ID
+					tracesURL = s.RootModule().Resources["sumologic_http_source.traces"].Primary.Attributes["url"]
+					return nil
+				},
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_http_source.logs",
+			ImportState:       true,
+			ImportStateIdFunc: testImportStateIdFunc("sumologic_http_source.logs", "collector_id", "id"),
+			ImportStateVerify: true,
+			// The trigger only lives in the configuration.
+			ImportStateVerifyIgnore: []string{"rotation_trigger"},
+		},
+		resource.TestStep{
+			// Resources using the URL get the rotated one in the same apply.
+			Config: config(tokenSettings("2021-11"), "content_type = \"Otlp\"\n\tpaused = true\n\trotation_trigger = \"1\""),
+			Check: resource.ComposeTestCheckFunc(
+				func(s *terraform.State) error {
+					if url := s.RootModule().Resources["sumologic_http_source.traces"].Primary.Attributes["url"]; url == tracesURL {
+						return fmt.Errorf("expected a new URL, got %q", url)
+					}
+					return nil
+				},
+				resource.TestCheckResourceAttrPair("sumologic_collector.consumer", "description",
+					"sumologic_http_source.traces", "url"),
+			),
+		},
+		resource.TestStep{
+			// Content types are updated in place.
+			Config: config(tokenSettings("2021-11"), "content_type = \"Zipkin\"\n\tpaused = true\n\trotation_trigger = \"1\""),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttrPtr("sumologic_http_source.traces", "id", &tracesID),
+				api.checkObject("sumologic_http_source.traces", sourcePath, map[string]interface{}{"contentType": "Zipkin"}),
+			),
+		},
+	)
+}
@@ -0,0 +1,305 @@
+package sumologic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// Installed collectors register themselves when they are installed on a host,
+// so this resource adopts an existing collector instead of creating one.
+func resourceSumologicInstalledCollector() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceSumologicInstalledCollectorCreate,
+		Read:          resourceSumologicInstalledCollectorRead,
+		Delete:        resourceSumologicInstalledCollectorDelete,
+		Update:        resourceSumologicInstalledCollectorUpdate,
+		CustomizeDiff: resourceSumologicInstalledCollectorCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourceSumologicInstalledCollectorImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"collector_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"fields": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"ephemeral": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"cutoff_timestamp": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"target_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delete_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"host_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"alive": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_seen_alive": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"collector_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceSumologicInstalledCollectorRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id, _ := strconv.Atoi(d.Id())
+	collector, err := c.GetCollector(id)
+	if err != nil {
+		return err
+	}
+
+	if collector == nil {
+		log.Printf("[WARN] Installed collector not found, removing from state: %v", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("collector_id", collector.ID)
+	d.Set("name", collector.Name)
+	d.Set("description", collector.Description)
+	d.Set("category", collector.Category)
+	d.Set("timezone", collector.TimeZone)
+	if err := d.Set("fields", collector.Fields); err != nil {
+		return fmt.Errorf("error setting fields for resource %s: %s", d.Id(), err)
+	}
+	d.Set("ephemeral", collector.Ephemeral != nil && *collector.Ephemeral)
+	if collector.CutoffTimestamp != nil {
+		d.Set("cutoff_timestamp", *collector.CutoffTimestamp)
+	} else {
+		d.Set("cutoff_timestamp", 0)
+	}
+	d.Set("host_name", collector.HostName)
+	d.Set("alive", collector.Alive)
+	d.Set("last_seen_alive", collector.LastSeenAlive)
+	d.Set("collector_version", collector.CollectorVersion)
+	d.Set("etag", collector.ETag)
+
+	return nil
+}
+
+// resourceSumologicInstalledCollectorCreate adopts the installed collector
+// with the given collector_id or, failing that, name.
+func resourceSumologicInstalledCollectorCreate(d *schema.ResourceData, meta interface{}) error {
+	c, cancel := meta.(*Client).withTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	var collector *Collector
+	var err error
+	if id, ok := d.GetOk("collector_id"); ok {
+		collector, err = c.GetCollector(id.(int))
+		if err == nil && collector == nil {
+			err = fmt.Errorf("collector with id '%d' does not exist", id.(int))
+		}
+	} else {
+		collector, err = c.GetCollectorName(d.Get("name").(string))
+	}
+	if err != nil {
+		return err
+	}
+
+	if collector.CollectorType != "Installable" {
+		return fmt.Errorf("collector '%s' is a %s collector, use sumologic_collector to manage it",
+			collector.Name, collector.CollectorType)
+	}
+
+	d.SetId(strconv.FormatInt(collector.ID, 10))
+	d.Set("etag", collector.ETag)
+
+	return resourceSumologicInstalledCollectorUpdate(d, meta)
+}
+
+func resourceSumologicInstalledCollectorUpdate(d *schema.ResourceData, meta interface{}) error {
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	c, cancel := meta.(*Client).withTimeout(timeout)
+	defer cancel()
+
+	id, _ := strconv.Atoi(d.Id())
+	// Start from the current collector so that the settings made on the host,
+	// e.g. the source sync mode, are preserved.
+	collector, err := c.GetCollector(id)
+	if err != nil {
+		return err
+	}
+	if collector == nil {
+		return fmt.Errorf("collector with id '%d' does not exist", id)
+	}
+
+	collector.Name = d.Get("name").(string)
+	collector.Description = d.Get("description").(string)
+	collector.Category = d.Get("category").(string)
+	if timezone, ok := d.GetOk("timezone"); ok {
+		collector.TimeZone = timezone.(string)
+	}
+	collector.Fields = d.Get("fields").(map[string]interface{})
+	ephemeral := d.Get("ephemeral").(bool)
+	collector.Ephemeral = &ephemeral
+	cutoffTimestamp := int64(d.Get("cutoff_timestamp").(int))
+	collector.CutoffTimestamp = &cutoffTimestamp
+	// Only succeed if the collector is still in the state last read.
+	collector.ETag = d.Get("etag").(string)
+
+	// The collector reports alive and the like, they cannot be updated.
+	update := *collector
+	update.Alive = false
+	update.LastSeenAlive = 0
+	update.Links = nil
+	if err = c.UpdateCollector(update); err != nil {
+		return err
+	}
+
+	targetVersion := d.Get("target_version").(string)
+	if targetVersion != "" && targetVersion != collector.CollectorVersion {
+		if err = upgradeCollector(c, *collector, targetVersion, timeout); err != nil {
+			return err
+		}
+	}
+
+	return resourceSumologicInstalledCollectorRead(d, meta)
+}
+
+// upgradeCollector upgrades the collector to targetVersion and waits for the
+// upgrade to finish.
+func upgradeCollector(c *Client, collector Collector, targetVersion string, timeout time.Duration) error {
+	if !collector.Alive {
+		return fmt.Errorf("collector '%s' is not alive and cannot be upgraded to %s", collector.Name, targetVersion)
+	}
+
+	upgradeID, err := c.UpgradeCollector(collector.ID, targetVersion)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Upgrading collector %d from %s to %s, upgrade task id: %s",
+		collector.ID, collector.CollectorVersion, targetVersion, upgradeID)
+
+	conf := &resource.StateChangeConf{
+		Pending: []string{
+			strconv.Itoa(collectorUpgradeNotStarted),
+			strconv.Itoa(collectorUpgradeInProgress),
+		},
+		Target: []string{
+			strconv.Itoa(collectorUpgradeSucceeded),
+		},
+		Refresh: func() (interface{}, string, error) {
+			upgrade, err := c.GetCollectorUpgrade(upgradeID)
+			if err != nil {
+				return nil, "", err
+			}
+			if upgrade.Status != collectorUpgradeNotStarted && upgrade.Status != collectorUpgradeInProgress &&
+				upgrade.Status != collectorUpgradeSucceeded {
+				return upgrade, strconv.Itoa(upgrade.Status),
+					fmt.Errorf("upgrade of collector '%s' to %s failed: %s", collector.Name, targetVersion, upgrade.Message)
+			}
+			return upgrade, strconv.Itoa(upgrade.Status), nil
+		},
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = conf.WaitForState()
+	return err
+}
+
+// resourceSumologicInstalledCollectorDelete only removes the collector from
+// the state, unless delete_on_destroy is set: deleting an installed collector
+// unregisters it from the host it runs on.
+func resourceSumologicInstalledCollectorDelete(d *schema.ResourceData, meta interface{}) error {
+	if !d.Get("delete_on_destroy").(bool) {
+		log.Printf("[DEBUG] Leaving installed collector %s in place, delete_on_destroy is not set", d.Id())
+		return nil
+	}
+
+	c := meta.(*Client)
+	id, _ := strconv.Atoi(d.Id())
+	return c.DeleteCollector(id)
+}
+
+// resourceSumologicInstalledCollectorCustomizeDiff plans an upgrade when the
+// collector does not run target_version.
+func resourceSumologicInstalledCollectorCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	targetVersion := d.Get("target_version").(string)
+	if d.Id() != "" && targetVersion != "" && targetVersion != d.Get("collector_version").(string) {
+		return d.SetNewComputed("collector_version")
+	}
+	return nil
+}
+
+// resourceSumologicInstalledCollectorImport accepts the collector id or name.
+func resourceSumologicInstalledCollectorImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.Atoi(d.Id()); err != nil {
+		collector, err := meta.(*Client).GetCollectorName(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(strconv.FormatInt(collector.ID, 10))
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,77 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitSumologicInstalledCollector(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	api.seed("v1/collectors/42", map[string]interface{}{
+		"id": 42, "name": "host-collector", "collectorType": "Installable", "alive": true,
+		"collectorVersion": "19.300-1", "hostName": "host1", "sourceSyncMode": "UI", "timeZone": "Etc/UTC",
+	})
+	api.seed("v1/collectors/43", map[string]interface{}{
+		"id": 43, "name": "hosted-collector", "collectorType": "Hosted",
+	})
+	config := func(name, targetVersion string) string {
+		return fmt.Sprintf(`
+resource "sumologic_installed_collector" "test" {
+	name = "%s"
+	category = "hosts"
+	ephemeral = true
+	target_version = "%s"
+	fields = {
+		environment = "production"
+	}
+}`, name, targetVersion)
+	}
+	collectorPath := func(attributes map[string]string) string {
+		return "v1/collectors/" + attributes["id"]
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config:      config("hosted-collector", ""),
+			ExpectError: regexp.MustCompile("is a Hosted collector"),
+		},
+		resource.TestStep{
+			Config: config("host-collector", ""),
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_installed_collector.test", collectorPath, map[string]interface{}{
+					"category": "hosts", "ephemeral": true, "sourceSyncMode": "UI", "timeZone": "Etc/UTC",
+				}),
+				resource.TestCheckResourceAttr("sumologic_installed_collector.test", "id", "42"),
+				resource.TestCheckResourceAttr("sumologic_installed_collector.test", "alive", "true"),
+				resource.TestCheckResourceAttr("sumologic_installed_collector.test", "host_name", "host1"),
+				resource.TestCheckResourceAttr("sumologic_installed_collector.test", "collector_version", "19.300-1"),
+			),
+		},
+		resource.TestStep{
+			Config: config("host-collector", "19.400-2"),
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_installed_collector.test", collectorPath, map[string]interface{}{
+					"collectorVersion": "19.400-2",
+				}),
+				resource.TestCheckResourceAttr("sumologic_installed_collector.test", "collector_version", "19.400-2"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:            "sumologic_installed_collector.test",
+			ImportState:             true,
+			ImportStateId:           "host-collector",
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"target_version", "delete_on_destroy"},
+		},
+		resource.TestStep{
+			Config: strings.Replace(config("host-collector", "19.400-2"), "ephemeral = true", "ephemeral = false", 1),
+			Check: api.checkObject("sumologic_installed_collector.test", collectorPath, map[string]interface{}{
+				"ephemeral": false, "cutoffTimestamp": 0,
+			}),
+		},
+	)
+}
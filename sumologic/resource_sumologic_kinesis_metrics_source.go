@@ -30,6 +30,7 @@ func resourceSumologicKinesisMetricsSource() *schema.Resource {
 		Optional: true,
 		Default:  false,
 	}
+	kinesisMetricsSource.Schema["paused"] = sourcePausedSchema()
 	kinesisMetricsSource.Schema["url"] = &schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
@@ -172,6 +173,7 @@ func resourceSumologicKinesisMetricsSourceRead(d *schema.ResourceData, meta inte
 	}
 	d.Set("content_type", source.ContentType)
 	d.Set("message_per_request", source.MessagePerRequest)
+	d.Set("paused", source.Paused)
 	d.Set("url", source.URL)
 
 	return nil
@@ -184,6 +186,7 @@ func resourceToKinesisMetricsSource(d *schema.ResourceData) (KinesisMetricsSourc
 	kinesisMetricsSource := KinesisMetricsSource{
 		Source:            source,
 		MessagePerRequest: d.Get("message_per_request").(bool),
+		Paused:            d.Get("paused").(bool),
 		URL:               d.Get("url").(string),
 	}
 
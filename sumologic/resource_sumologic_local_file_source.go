@@ -0,0 +1,112 @@
+package sumologic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceSumologicLocalFileSource() *schema.Resource {
+	localFileSource := resourceSumologicSource()
+	localFileSource.Create = resourceSumologicLocalFileSourceCreate
+	localFileSource.Read = resourceSumologicLocalFileSourceRead
+	localFileSource.Update = resourceSumologicLocalFileSourceUpdate
+	localFileSource.Importer = &schema.ResourceImporter{
+		State: resourceSumologicSourceImport,
+	}
+
+	localFileSource.Schema["path_expression"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	localFileSource.Schema["denylist"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	localFileSource.Schema["encoding"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "UTF-8",
+	}
+
+	return localFileSource
+}
+
+func resourceSumologicLocalFileSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	if d.Id() == "" {
+		source := resourceToLocalFileSource(d)
+
+		id, err := c.CreateLocalFileSource(source, d.Get("collector_id").(int))
+
+		if err != nil {
+			return err
+		}
+
+		d.SetId(strconv.Itoa(id))
+	}
+
+	return resourceSumologicLocalFileSourceRead(d, meta)
+}
+
+func resourceSumologicLocalFileSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	source := resourceToLocalFileSource(d)
+
+	err := c.UpdateLocalFileSource(source, d.Get("collector_id").(int))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceSumologicLocalFileSourceRead(d, meta)
+}
+
+func resourceToLocalFileSource(d *schema.ResourceData) LocalFileSource {
+	source := resourceToSource(d)
+	source.Type = "LocalFile"
+
+	localFileSource := LocalFileSource{
+		Source:         source,
+		PathExpression: d.Get("path_expression").(string),
+		Denylist:       getStringList(d, "denylist"),
+		Encoding:       d.Get("encoding").(string),
+	}
+
+	return localFileSource
+}
+
+func resourceSumologicLocalFileSourceRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id, _ := strconv.Atoi(d.Id())
+	source, err := c.GetLocalFileSource(d.Get("collector_id").(int), id)
+	if err != nil {
+		return err
+	}
+
+	if source == nil {
+		log.Printf("[WARN] Local file source not found, removing from state: %v - %v", id, err)
+		d.SetId("")
+
+		return nil
+	}
+
+	if err := resourceSumologicSourceRead(d, source.Source); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	d.Set("path_expression", source.PathExpression)
+	if err := d.Set("denylist", source.Denylist); err != nil {
+		return fmt.Errorf("error setting denylist for resource %s: %s", d.Id(), err)
+	}
+	d.Set("encoding", source.Encoding)
+
+	return nil
+}
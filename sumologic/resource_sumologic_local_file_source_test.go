@@ -0,0 +1,56 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitSumologicLocalFileSource(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	api.seed("v1/collectors/42", map[string]interface{}{
+		"id": 42, "name": "host-collector", "collectorType": "Installable",
+	})
+	config := `
+resource "sumologic_local_file_source" "test" {
+	collector_id = 42
+	name = "messages"
+	category = "hosts/messages"
+	path_expression = "/var/log/messages"
+	denylist = ["/var/log/messages.1"]
+	multiline_processing_enabled = true
+	use_autoline_matching = false
+	manual_prefix_regexp = "\\d{4}-\\d{2}-\\d{2}"
+	filters {
+		name = "exclude debug"
+		filter_type = "Exclude"
+		regexp = ".*DEBUG.*"
+	}
+	default_date_formats {
+		format = "yyyy-MM-dd HH:mm:ss"
+	}
+}`
+	sourcePath := func(attributes map[string]string) string {
+		return fmt.Sprintf("v1/collectors/%s/sources/%s", attributes["collector_id"], attributes["id"])
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: config,
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_local_file_source.test", sourcePath, map[string]interface{}{
+					"sourceType": "LocalFile", "pathExpression": "/var/log/messages", "encoding": "UTF-8",
+					"multilineProcessingEnabled": true, "manualPrefixRegexp": `\d{4}-\d{2}-\d{2}`,
+				}),
+				resource.TestCheckResourceAttr("sumologic_local_file_source.test", "filters.0.filter_type", "Exclude"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_local_file_source.test",
+			ImportState:       true,
+			ImportStateIdFunc: testImportStateIdFunc("sumologic_local_file_source.test", "collector_id", "id"),
+			ImportStateVerify: true,
+		},
+	)
+}
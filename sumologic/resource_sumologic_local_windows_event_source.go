@@ -0,0 +1,139 @@
+package sumologic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSumologicLocalWindowsEventSource() *schema.Resource {
+	localWindowsEventSource := resourceSumologicSource()
+	localWindowsEventSource.Create = resourceSumologicLocalWindowsEventSourceCreate
+	localWindowsEventSource.Read = resourceSumologicLocalWindowsEventSourceRead
+	localWindowsEventSource.Update = resourceSumologicLocalWindowsEventSourceUpdate
+	localWindowsEventSource.Importer = &schema.ResourceImporter{
+		State: resourceSumologicSourceImport,
+	}
+
+	localWindowsEventSource.Schema["log_names"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	localWindowsEventSource.Schema["render_messages"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+	// 0 collects the events in the legacy format, 1 as JSON.
+	localWindowsEventSource.Schema["event_format"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntBetween(0, 1),
+	}
+	// Only used for JSON events: 0 collects the complete message, 1 the
+	// message title and 2 the metadata only.
+	localWindowsEventSource.Schema["event_message"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntBetween(0, 2),
+	}
+	localWindowsEventSource.Schema["allowlist"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	localWindowsEventSource.Schema["denylist"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return localWindowsEventSource
+}
+
+func resourceSumologicLocalWindowsEventSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	if d.Id() == "" {
+		source := resourceToLocalWindowsEventSource(d)
+
+		id, err := c.CreateLocalWindowsEventSource(source, d.Get("collector_id").(int))
+
+		if err != nil {
+			return err
+		}
+
+		d.SetId(strconv.Itoa(id))
+	}
+
+	return resourceSumologicLocalWindowsEventSourceRead(d, meta)
+}
+
+func resourceSumologicLocalWindowsEventSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	source := resourceToLocalWindowsEventSource(d)
+
+	err := c.UpdateLocalWindowsEventSource(source, d.Get("collector_id").(int))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceSumologicLocalWindowsEventSourceRead(d, meta)
+}
+
+func resourceToLocalWindowsEventSource(d *schema.ResourceData) LocalWindowsEventSource {
+	source := resourceToSource(d)
+	source.Type = "LocalWindowsEventLog"
+
+	localWindowsEventSource := LocalWindowsEventSource{
+		Source:         source,
+		LogNames:       getStringList(d, "log_names"),
+		RenderMessages: d.Get("render_messages").(bool),
+		EventFormat:    d.Get("event_format").(int),
+		EventMessage:   d.Get("event_message").(int),
+		Allowlist:      d.Get("allowlist").(string),
+		Denylist:       d.Get("denylist").(string),
+	}
+
+	return localWindowsEventSource
+}
+
+func resourceSumologicLocalWindowsEventSourceRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id, _ := strconv.Atoi(d.Id())
+	source, err := c.GetLocalWindowsEventSource(d.Get("collector_id").(int), id)
+	if err != nil {
+		return err
+	}
+
+	if source == nil {
+		log.Printf("[WARN] Local Windows event source not found, removing from state: %v - %v", id, err)
+		d.SetId("")
+
+		return nil
+	}
+
+	if err := resourceSumologicSourceRead(d, source.Source); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	if err := d.Set("log_names", source.LogNames); err != nil {
+		return fmt.Errorf("error setting log names for resource %s: %s", d.Id(), err)
+	}
+	d.Set("render_messages", source.RenderMessages)
+	d.Set("event_format", source.EventFormat)
+	d.Set("event_message", source.EventMessage)
+	d.Set("allowlist", source.Allowlist)
+	d.Set("denylist", source.Denylist)
+
+	return nil
+}
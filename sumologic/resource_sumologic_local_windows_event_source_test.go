@@ -0,0 +1,42 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitSumologicLocalWindowsEventSource(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	api.seed("v1/collectors/42", map[string]interface{}{
+		"id": 42, "name": "host-collector", "collectorType": "Installable",
+	})
+	config := `
+resource "sumologic_local_windows_event_source" "test" {
+	collector_id = 42
+	name = "windows"
+	log_names = ["Security", "Application"]
+	event_format = 1
+	denylist = "4624,4625"
+}`
+	sourcePath := func(attributes map[string]string) string {
+		return fmt.Sprintf("v1/collectors/%s/sources/%s", attributes["collector_id"], attributes["id"])
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: config,
+			Check: api.checkObject("sumologic_local_windows_event_source.test", sourcePath, map[string]interface{}{
+				"sourceType": "LocalWindowsEventLog", "eventFormat": float64(1), "renderMessages": true,
+				"denylist": "4624,4625",
+			}),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_local_windows_event_source.test",
+			ImportState:       true,
+			ImportStateIdFunc: testImportStateIdFunc("sumologic_local_windows_event_source.test", "collector_id", "id"),
+			ImportStateVerify: true,
+		},
+	)
+}
@@ -122,7 +122,6 @@ func resourceSumologicLookupTableRead(d *schema.ResourceData, meta interface{})
 
 	id := d.Id()
 	lookupTable, err := c.GetLookupTable(id)
-	log.Printf("##DEBUG## read lookup: %+v\n", lookupTable)
 	if err != nil {
 		return err
 	}
@@ -149,7 +148,7 @@ func resourceSumologicLookupTableRead(d *schema.ResourceData, meta interface{})
 func resourceSumologicLookupTableDelete(d *schema.ResourceData, meta interface{}) error {
 	c := meta.(*Client)
 
-	log.Printf("##DEBUG## resourceSumologicLookupTableDelete: %s", d.Id())
+	log.Printf("[DEBUG] Deleting lookup table: %s", d.Id())
 	return c.DeleteLookupTable(d.Id())
 }
 
@@ -24,14 +24,8 @@ func resourceSumologicMetadataSource() *schema.Resource {
 		ForceNew:     true,
 		ValidateFunc: validation.StringInSlice([]string{"AwsMetadata"}, false),
 	}
-	pollingMetadataSource.Schema["scan_interval"] = &schema.Schema{
-		Type:     schema.TypeInt,
-		Required: true,
-	}
-	pollingMetadataSource.Schema["paused"] = &schema.Schema{
-		Type:     schema.TypeBool,
-		Required: true,
-	}
+	pollingMetadataSource.Schema["scan_interval"] = sourceScanIntervalSchema()
+	pollingMetadataSource.Schema["paused"] = sourcePausedSchema()
 	pollingMetadataSource.Schema["url"] = &schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
@@ -0,0 +1,309 @@
+package sumologic
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceSumologicMonitorMute silences the notifications of monitors during
+// one-off or recurring windows. Sumo Logic lifts the mute at the end of each
+// window, the resource reports whether the monitors are muted when it is
+// read.
+func resourceSumologicMonitorMute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSumologicMonitorMuteCreate,
+		Read:   resourceSumologicMonitorMuteRead,
+		Update: resourceSumologicMonitorMuteUpdate,
+		Delete: resourceSumologicMonitorMuteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"monitor_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"monitor_ids", "folder_ids"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"folder_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"monitor_ids", "folder_ids"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timezone": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateTimeZone,
+						},
+						"start_date": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateMuteLayout(muteDateLayout, "a date (YYYY-MM-DD)"),
+						},
+						"start_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"schedule.0.start_time", "schedule.0.cron"},
+							ValidateFunc: validateMuteLayout(muteTimeLayout, "a time of day (HH:MM)"),
+						},
+						"duration": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateMuteDuration,
+							DiffSuppressFunc: suppressEquivalentMuteDurations,
+						},
+						"rrule": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"schedule.0.cron"},
+							ValidateFunc:  validateMuteRRule,
+						},
+						"cron": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"schedule.0.start_time", "schedule.0.cron"},
+							ValidateFunc: validateMuteCron,
+						},
+					},
+				},
+			},
+			"muted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"window_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"window_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateTimeZone(i interface{}, k string) (warnings []string, errors []error) {
+	if _, err := time.LoadLocation(i.(string)); err != nil || i.(string) == "" {
+		errors = append(errors, fmt.Errorf("%s must be an IANA time zone, e.g. Europe/Berlin, got %q", k, i))
+	}
+	return warnings, errors
+}
+
+func validateMuteLayout(layout, description string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		if _, err := time.Parse(layout, i.(string)); err != nil {
+			errors = append(errors, fmt.Errorf("%s must be %s, got %q", k, description, i))
+		}
+		return warnings, errors
+	}
+}
+
+func validateMuteDuration(i interface{}, k string) (warnings []string, errors []error) {
+	duration, err := time.ParseDuration(i.(string))
+	if err != nil || duration <= 0 || duration%time.Minute != 0 {
+		errors = append(errors, fmt.Errorf("%s must be a positive number of minutes, e.g. 90m or 2h, got %q", k, i))
+	}
+	return warnings, errors
+}
+
+func suppressEquivalentMuteDurations(k, old, new string, d *schema.ResourceData) bool {
+	oldDuration, oldErr := time.ParseDuration(old)
+	newDuration, newErr := time.ParseDuration(new)
+	return oldErr == nil && newErr == nil && oldDuration == newDuration
+}
+
+func validateMuteRRule(i interface{}, k string) (warnings []string, errors []error) {
+	if _, err := parseMuteRRule(i.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s is not a supported RRULE: %s", k, err))
+	}
+	return warnings, errors
+}
+
+func validateMuteCron(i interface{}, k string) (warnings []string, errors []error) {
+	if _, _, err := cronToMuteRRule(i.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s is not a supported cron expression: %s", k, err))
+	}
+	return warnings, errors
+}
+
+func resourceSumologicMonitorMuteCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id, err := c.CreateMonitorMute(resourceToMonitorMute(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	return resourceSumologicMonitorMuteRead(d, meta)
+}
+
+func resourceSumologicMonitorMuteRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	mute, err := c.GetMonitorMute(d.Id())
+	if err != nil {
+		return err
+	}
+	if mute == nil {
+		log.Printf("[WARN] Monitor mute not found, removing from state: %v", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", mute.Name)
+	d.Set("description", mute.Description)
+
+	// The API takes monitors and folders in one list, IDs that are not in
+	// the state, e.g. on import, are looked up.
+	monitorIDs := d.Get("monitor_ids").(*schema.Set)
+	folderIDs := d.Get("folder_ids").(*schema.Set)
+	var monitors, folders []interface{}
+	for _, id := range mute.Monitor.IDs {
+		switch {
+		case monitorIDs.Contains(id):
+			monitors = append(monitors, id)
+		case folderIDs.Contains(id):
+			folders = append(folders, id)
+		default:
+			item, err := c.GetMonitorsLibraryFolder(id)
+			if err != nil {
+				return err
+			}
+			if item != nil && item.Type == "MonitorsLibraryFolder" {
+				folders = append(folders, id)
+			} else {
+				monitors = append(monitors, id)
+			}
+		}
+	}
+	if err := d.Set("monitor_ids", monitors); err != nil {
+		return err
+	}
+	if err := d.Set("folder_ids", folders); err != nil {
+		return err
+	}
+
+	schedule := map[string]interface{}{
+		"timezone":   mute.Schedule.TimeZone,
+		"start_date": mute.Schedule.StartDate,
+		"start_time": mute.Schedule.StartTime,
+		"duration":   (time.Duration(mute.Schedule.Duration) * time.Minute).String(),
+		"rrule":      mute.Schedule.RRule,
+		"cron":       "",
+	}
+	// Keep a cron expression that converts to the schedule.
+	if cron := d.Get("schedule.0.cron").(string); cron != "" {
+		startTime, rrule, err := cronToMuteRRule(cron)
+		if err == nil && startTime == mute.Schedule.StartTime && rrule == mute.Schedule.RRule {
+			schedule["start_time"] = ""
+			schedule["rrule"] = ""
+			schedule["cron"] = cron
+		}
+	}
+	if err := d.Set("schedule", []interface{}{schedule}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	start, end, ok, err := muteWindow(mute.Schedule, now)
+	status := "Expired"
+	switch {
+	case err != nil:
+		// The schedule was changed outside Terraform to one the provider
+		// cannot evaluate.
+		log.Printf("[WARN] Cannot evaluate the schedule of monitor mute %s: %s", d.Id(), err)
+		status = "Unknown"
+	case ok && now.Before(start):
+		status = "Scheduled"
+	case ok:
+		status = "Active"
+	}
+	d.Set("status", status)
+	d.Set("muted", status == "Active")
+	if ok {
+		d.Set("window_start", start.Format(time.RFC3339))
+		d.Set("window_end", end.Format(time.RFC3339))
+	} else {
+		d.Set("window_start", "")
+		d.Set("window_end", "")
+	}
+
+	return nil
+}
+
+func resourceSumologicMonitorMuteUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	if err := c.UpdateMonitorMute(resourceToMonitorMute(d)); err != nil {
+		return err
+	}
+	return resourceSumologicMonitorMuteRead(d, meta)
+}
+
+func resourceSumologicMonitorMuteDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+	return c.DeleteMonitorMute(d.Id())
+}
+
+func resourceToMonitorMute(d *schema.ResourceData) MonitorMute {
+	var ids []string
+	for _, attribute := range []string{"monitor_ids", "folder_ids"} {
+		for _, id := range d.Get(attribute).(*schema.Set).List() {
+			ids = append(ids, id.(string))
+		}
+	}
+	sort.Strings(ids)
+
+	// The attributes are validated.
+	duration, _ := time.ParseDuration(d.Get("schedule.0.duration").(string))
+	schedule := MonitorMuteSchedule{
+		TimeZone:  d.Get("schedule.0.timezone").(string),
+		StartDate: d.Get("schedule.0.start_date").(string),
+		StartTime: d.Get("schedule.0.start_time").(string),
+		Duration:  int(duration / time.Minute),
+		RRule:     d.Get("schedule.0.rrule").(string),
+	}
+	if cron := d.Get("schedule.0.cron").(string); cron != "" {
+		schedule.StartTime, schedule.RRule, _ = cronToMuteRRule(cron)
+	}
+
+	return MonitorMute{
+		ID:          d.Id(),
+		Type:        "MutingSchedulesLibraryMutingSchedule",
+		ContentType: "MutingSchedule",
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Monitor:     MonitorMuteScope{IDs: ids},
+		Schedule:    schedule,
+	}
+}
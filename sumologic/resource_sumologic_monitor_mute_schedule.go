@@ -0,0 +1,354 @@
+package sumologic
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The windows of a monitor mute recur by an RRULE (RFC 5545), or by a cron
+// expression that is converted to one, as the API only takes RRULEs. The
+// provider evaluates the rule itself to report whether the monitors are
+// muted, so only the parts of RRULE that map to a start date and time are
+// supported: a daily, weekly or monthly frequency with an interval, filtered
+// by day of week, day of month and month, and bounded by a count or an end.
+
+const (
+	muteDateLayout  = "2006-01-02"
+	muteTimeLayout  = "15:04"
+	muteUntilLayout = "20060102T150405Z"
+)
+
+// muteMaxYears bounds the search for the windows of a rule that matches
+// rarely, or never, e.g. BYMONTH=2;BYMONTHDAY=30.
+const muteMaxYears = 10
+
+var muteWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+var cronWeekdays = map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}
+
+var cronMonths = map[string]int{"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6, "JUL": 7, "AUG": 8,
+	"SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12}
+
+type muteRecurrence struct {
+	freq     string
+	interval int
+	count    int
+	// until is the end of the windows' starts, exclusive.
+	until      time.Time
+	byDay      []time.Weekday
+	byMonthDay []int
+	byMonth    []time.Month
+}
+
+func parseMuteRRule(rrule string) (*muteRecurrence, error) {
+	r := &muteRecurrence{interval: 1}
+	for _, part := range strings.Split(strings.TrimPrefix(rrule, "RRULE:"), ";") {
+		keyValue := strings.SplitN(part, "=", 2)
+		if len(keyValue) != 2 {
+			return nil, fmt.Errorf("%q is not a KEY=VALUE pair", part)
+		}
+		key, value := keyValue[0], keyValue[1]
+		var err error
+		switch key {
+		case "FREQ":
+			if value != "DAILY" && value != "WEEKLY" && value != "MONTHLY" {
+				return nil, fmt.Errorf("FREQ must be DAILY, WEEKLY or MONTHLY, got %s", value)
+			}
+			r.freq = value
+		case "INTERVAL":
+			r.interval, err = parseRRuleNumber(key, value, 1, 1000)
+		case "COUNT":
+			r.count, err = parseRRuleNumber(key, value, 1, 100000)
+		case "UNTIL":
+			// until is exclusive, an UNTIL date includes the whole day.
+			if until, timeErr := time.Parse(muteUntilLayout, value); timeErr == nil {
+				r.until = until.Add(time.Second)
+			} else if until, dateErr := time.Parse("20060102", value); dateErr == nil {
+				r.until = until.AddDate(0, 0, 1)
+			} else {
+				err = fmt.Errorf("UNTIL must be a date (YYYYMMDD) or a UTC time (YYYYMMDDTHHMMSSZ), got %s", value)
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := muteWeekdays[day]
+				if !ok {
+					return nil, fmt.Errorf("BYDAY must be a list of MO, TU, WE, TH, FR, SA and SU, got %s", value)
+				}
+				r.byDay = append(r.byDay, weekday)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				n, err := parseRRuleNumber(key, day, 1, 31)
+				if err != nil {
+					return nil, err
+				}
+				r.byMonthDay = append(r.byMonthDay, n)
+			}
+		case "BYMONTH":
+			for _, month := range strings.Split(value, ",") {
+				n, err := parseRRuleNumber(key, month, 1, 12)
+				if err != nil {
+					return nil, err
+				}
+				r.byMonth = append(r.byMonth, time.Month(n))
+			}
+		default:
+			return nil, fmt.Errorf("%s is not supported", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.freq == "" {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+	if r.count > 0 && !r.until.IsZero() {
+		return nil, fmt.Errorf("COUNT and UNTIL cannot both be set")
+	}
+	return r, nil
+}
+
+func parseRRuleNumber(key, value string, min, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("%s must be a number between %d and %d, got %s", key, min, max, value)
+	}
+	return n, nil
+}
+
+// matches returns whether the rule has a window on the date, a time of day
+// at midnight UTC, given the date of the first window.
+func (r *muteRecurrence) matches(date, start time.Time) bool {
+	byDay, byMonthDay := r.byDay, r.byMonthDay
+	switch r.freq {
+	case "DAILY":
+		if int(date.Sub(start).Hours()/24)%r.interval != 0 {
+			return false
+		}
+	case "WEEKLY":
+		// Weeks start on Monday.
+		monday := func(t time.Time) time.Time { return t.AddDate(0, 0, -(int(t.Weekday())+6)%7) }
+		if int(monday(date).Sub(monday(start)).Hours()/24/7)%r.interval != 0 {
+			return false
+		}
+		if len(byDay) == 0 {
+			byDay = []time.Weekday{start.Weekday()}
+		}
+	case "MONTHLY":
+		months := (date.Year()-start.Year())*12 + int(date.Month()) - int(start.Month())
+		if months%r.interval != 0 {
+			return false
+		}
+		if len(byDay) == 0 && len(byMonthDay) == 0 {
+			byMonthDay = []int{start.Day()}
+		}
+	}
+
+	if len(r.byMonth) > 0 && !containsMonth(r.byMonth, date.Month()) {
+		return false
+	}
+	if len(byMonthDay) > 0 && !containsInt(byMonthDay, date.Day()) {
+		return false
+	}
+	if len(byDay) > 0 && !containsWeekday(byDay, date.Weekday()) {
+		return false
+	}
+	return true
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsMonth(values []time.Month, value time.Month) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsWeekday(values []time.Weekday, value time.Weekday) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// muteWindow returns the window of the schedule that is open at now, or else
+// the next one. ok is false if the schedule has no window left.
+func muteWindow(schedule MonitorMuteSchedule, now time.Time) (start, end time.Time, ok bool, err error) {
+	location, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		return start, end, false, err
+	}
+	startDate, err := time.Parse(muteDateLayout, schedule.StartDate)
+	if err != nil {
+		return start, end, false, err
+	}
+	startTime, err := time.Parse(muteTimeLayout, schedule.StartTime)
+	if err != nil {
+		return start, end, false, err
+	}
+	duration := time.Duration(schedule.Duration) * time.Minute
+	window := func(date time.Time) time.Time {
+		return time.Date(date.Year(), date.Month(), date.Day(), startTime.Hour(), startTime.Minute(), 0, 0, location)
+	}
+
+	if schedule.RRule == "" {
+		start = window(startDate)
+		end = start.Add(duration)
+		return start, end, now.Before(end), nil
+	}
+
+	r, err := parseMuteRRule(schedule.RRule)
+	if err != nil {
+		return start, end, false, err
+	}
+	last := now
+	if first := window(startDate); first.After(last) {
+		last = first
+	}
+	last = last.AddDate(muteMaxYears, 0, 0)
+	occurrences := 0
+	for date := startDate; !window(date).After(last); date = date.AddDate(0, 0, 1) {
+		if !r.matches(date, startDate) {
+			continue
+		}
+		occurrences++
+		start = window(date)
+		if r.count > 0 && occurrences > r.count || !r.until.IsZero() && !start.Before(r.until) {
+			break
+		}
+		if end = start.Add(duration); now.Before(end) {
+			return start, end, true, nil
+		}
+	}
+	return time.Time{}, time.Time{}, false, nil
+}
+
+// cronToMuteRRule converts a cron expression, minute hour day-of-month month
+// day-of-week, to the start time and RRULE of a mute.
+func cronToMuteRRule(cron string) (string, string, error) {
+	fields := strings.Fields(cron)
+	if len(fields) != 5 {
+		return "", "", fmt.Errorf("%q must have 5 fields: minute, hour, day of month, month and day of week", cron)
+	}
+	minute, err := strconv.Atoi(fields[0])
+	if err != nil || minute < 0 || minute > 59 {
+		return "", "", fmt.Errorf("the minute of %q must be a number between 0 and 59, windows start at a single time", cron)
+	}
+	hour, err := strconv.Atoi(fields[1])
+	if err != nil || hour < 0 || hour > 23 {
+		return "", "", fmt.Errorf("the hour of %q must be a number between 0 and 23, windows start at a single time", cron)
+	}
+	monthDays, err := parseCronList(fields[2], 1, 31, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("the day of month of %q: %s", cron, err)
+	}
+	months, err := parseCronList(fields[3], 1, 12, cronMonths)
+	if err != nil {
+		return "", "", fmt.Errorf("the month of %q: %s", cron, err)
+	}
+	weekdays, err := parseCronList(fields[4], 0, 7, cronWeekdays)
+	if err != nil {
+		return "", "", fmt.Errorf("the day of week of %q: %s", cron, err)
+	}
+	if len(monthDays) > 0 && len(weekdays) > 0 {
+		return "", "", fmt.Errorf("%q cannot restrict both the day of month and the day of week", cron)
+	}
+
+	var rrule string
+	switch {
+	case len(weekdays) > 0:
+		// Sunday is both 0 and 7.
+		var days []string
+		for _, weekday := range weekdays {
+			day := strings.ToUpper(time.Weekday(weekday % 7).String()[:2])
+			if !containsString(days, day) {
+				days = append(days, day)
+			}
+		}
+		rrule = "FREQ=WEEKLY;BYDAY=" + strings.Join(days, ",")
+	case len(monthDays) > 0:
+		rrule = "FREQ=MONTHLY;BYMONTHDAY=" + joinInts(monthDays)
+	default:
+		rrule = "FREQ=DAILY"
+	}
+	if len(months) > 0 {
+		rrule += ";BYMONTH=" + joinInts(months)
+	}
+	return fmt.Sprintf("%02d:%02d", hour, minute), rrule, nil
+}
+
+// parseCronList parses a cron field of numbers, names and ranges, e.g.
+// MON-FRI or 1,15. It returns nil for *.
+func parseCronList(field string, min, max int, names map[string]int) ([]int, error) {
+	if field == "*" {
+		return nil, nil
+	}
+	value := func(s string) (int, error) {
+		if n, ok := names[strings.ToUpper(s)]; ok {
+			return n, nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < min || n > max {
+			return 0, fmt.Errorf("%q must be * or a list of values between %d and %d, or ranges of them", field, min, max)
+		}
+		return n, nil
+	}
+
+	var values []int
+	for _, item := range strings.Split(field, ",") {
+		bounds := strings.SplitN(item, "-", 2)
+		first, err := value(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		last := first
+		if len(bounds) == 2 {
+			if last, err = value(bounds[1]); err != nil {
+				return nil, err
+			}
+		}
+		if last < first {
+			return nil, fmt.Errorf("the range %q is reversed", item)
+		}
+		for n := first; n <= last; n++ {
+			if !containsInt(values, n) {
+				values = append(values, n)
+			}
+		}
+	}
+	return values, nil
+}
+
+func joinInts(values []int) string {
+	s := make([]string, len(values))
+	for i, value := range values {
+		s[i] = strconv.Itoa(value)
+	}
+	return strings.Join(s, ",")
+}
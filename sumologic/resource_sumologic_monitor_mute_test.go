@@ -0,0 +1,140 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitSumologicMonitorMute(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	api.seed("v1/monitors/00000000000000A1", map[string]interface{}{
+		"id": "00000000000000A1", "name": "Deploys", "type": "MonitorsLibraryFolder", "parentId": fakeMonitorsRootID,
+	})
+	api.seed("v1/monitors/00000000000000A2", map[string]interface{}{
+		"id": "00000000000000A2", "name": "Errors", "type": "MonitorsLibraryMonitor", "parentId": fakeMonitorsRootID,
+	})
+	config := func(scope, schedule string) string {
+		return fmt.Sprintf(`
+resource "sumologic_monitor_mute" "deploy" {
+	name = "Deploy"
+	%s
+	schedule {
+		timezone = "Europe/Berlin"
+		%s
+	}
+}`, scope, schedule)
+	}
+	scope := `
+	monitor_ids = ["00000000000000A2"]
+	folder_ids = ["00000000000000A1"]`
+	mutePath := func(attributes map[string]string) string {
+		return "v1/mutingSchedules/" + attributes["id"]
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config:      config("", `start_date = "2020-01-01"`+"\n"+`start_time = "22:00"`+"\n"+`duration = "2h"`),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("one of `folder_ids,monitor_ids` must be specified"),
+		},
+		resource.TestStep{
+			Config: config(scope, `start_date = "2020-01-01"
+		start_time = "22:00"
+		cron = "0 22 * * *"
+		duration = "2h"`),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("only one of `schedule.0.cron,schedule.0.start_time` can be specified"),
+		},
+		resource.TestStep{
+			Config: config(scope, `start_date = "2020-01-01"
+		cron = "*/5 22 * * *"
+		duration = "2h"`),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`the minute of "\*/5 22 \* \* \*" must be a number between 0 and 59`),
+		},
+		resource.TestStep{
+			Config: config(scope, `start_date = "2020-01-01"
+		start_time = "22:00"
+		duration = "90s"`),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("duration must be a positive number of minutes"),
+		},
+		resource.TestStep{
+			Config: config(scope, `start_date = "2020-01-01"
+		start_time = "22:00"
+		rrule = "FREQ=YEARLY"
+		duration = "2h"`),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("FREQ must be DAILY, WEEKLY or MONTHLY, got YEARLY"),
+		},
+		resource.TestStep{
+			Config: config(scope, `start_date = "2020-01-01"
+		start_time = "22:00"
+		duration = "2h"`),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("sumologic_monitor_mute.deploy", "status", "Expired"),
+				resource.TestCheckResourceAttr("sumologic_monitor_mute.deploy", "muted", "false"),
+				resource.TestCheckResourceAttr("sumologic_monitor_mute.deploy", "window_start", ""),
+				api.checkObject("sumologic_monitor_mute.deploy", mutePath, map[string]interface{}{
+					"name":    "Deploy",
+					"monitor": map[string]interface{}{"ids": []interface{}{"00000000000000A1", "00000000000000A2"}, "all": false},
+					"schedule": map[string]interface{}{
+						"timezone": "Europe/Berlin", "startDate": "2020-01-01", "startTime": "22:00", "duration": 120,
+					},
+				}),
+			),
+		},
+		resource.TestStep{
+			// Windows of a whole day every day.
+			Config: config(scope, `start_date = "2020-01-01"
+		cron = "0 0 * * *"
+		duration = "24h"`),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("sumologic_monitor_mute.deploy", "status", "Active"),
+				resource.TestCheckResourceAttr("sumologic_monitor_mute.deploy", "muted", "true"),
+				resource.TestCheckResourceAttr("sumologic_monitor_mute.deploy", "schedule.0.start_time", ""),
+				resource.TestCheckResourceAttr("sumologic_monitor_mute.deploy", "schedule.0.cron", "0 0 * * *"),
+				api.checkObject("sumologic_monitor_mute.deploy", mutePath, map[string]interface{}{
+					"schedule": map[string]interface{}{
+						"timezone": "Europe/Berlin", "startDate": "2020-01-01", "startTime": "00:00", "duration": 1440,
+						"rrule": "FREQ=DAILY",
+					},
+				}),
+			),
+		},
+		resource.TestStep{
+			Config: config(scope, `start_date = "2099-01-01"
+		start_time = "22:00"
+		rrule = "FREQ=WEEKLY;BYDAY=MO,FR;UNTIL=20991231"
+		duration = "90m"`),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("sumologic_monitor_mute.deploy", "status", "Scheduled"),
+				resource.TestCheckResourceAttr("sumologic_monitor_mute.deploy", "muted", "false"),
+				// 2099-01-01 is a Thursday.
+				resource.TestCheckResourceAttr("sumologic_monitor_mute.deploy", "window_start", "2099-01-02T22:00:00+01:00"),
+				resource.TestCheckResourceAttr("sumologic_monitor_mute.deploy", "window_end", "2099-01-02T23:30:00+01:00"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_monitor_mute.deploy",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update("v1/mutingSchedules/0000000000000001", func(mute map[string]interface{}) {
+					mute["schedule"].(map[string]interface{})["duration"] = 60
+				})
+			},
+			Config: config(scope, `start_date = "2099-01-01"
+		start_time = "22:00"
+		rrule = "FREQ=WEEKLY;BYDAY=MO,FR;UNTIL=20991231"
+		duration = "90m"`),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+	)
+}
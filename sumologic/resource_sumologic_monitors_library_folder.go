@@ -147,6 +147,7 @@ func resourceSumologicMonitorsLibraryFolderRead(d *schema.ResourceData, meta int
 	d.Set("is_mutable", folder.IsMutable)
 	d.Set("version", folder.Version)
 	d.Set("name", folder.Name)
+	d.Set("type", folder.Type)
 	d.Set("description", folder.Description)
 	d.Set("parent_id", folder.ParentID)
 	d.Set("modified_at", folder.ModifiedAt)
@@ -0,0 +1,42 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitSumologicMonitorFolder(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := func(description string) string {
+		return fmt.Sprintf(`
+resource "sumologic_monitor_folder" "test" {
+	name = "MyMonitorFolder"
+	description = "%s"
+}`, description)
+	}
+	folderPath := func(attributes map[string]string) string {
+		return "v1/monitors/" + attributes["id"]
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: config("First"),
+			Check: api.checkObject("sumologic_monitor_folder.test", folderPath, map[string]interface{}{
+				"name": "MyMonitorFolder", "description": "First", "parentId": fakeMonitorsRootID,
+			}),
+		},
+		resource.TestStep{
+			Config: config("Second"),
+			Check: api.checkObject("sumologic_monitor_folder.test", folderPath, map[string]interface{}{
+				"description": "Second",
+			}),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_monitor_folder.test",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	)
+}
@@ -1,9 +1,11 @@
 package sumologic
 
 import (
+	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -17,6 +19,10 @@ func resourceSumologicMonitorsLibraryMonitor() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customdiff.All(
+			resourceSumologicMonitorsLibraryMonitorCustomizeDiff,
+			resourceSumologicMonitorsLibraryMonitorNotificationsCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 
@@ -84,8 +90,9 @@ func resourceSumologicMonitorsLibraryMonitor() *schema.Resource {
 			},
 
 			"triggers": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:       schema.TypeList,
+				Optional:   true,
+				Deprecated: "The field `triggers` is deprecated and will be removed in a future release of the provider - please use `trigger_conditions` instead.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"trigger_type": {
@@ -100,12 +107,12 @@ func resourceSumologicMonitorsLibraryMonitor() *schema.Resource {
 						"threshold_type": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringInSlice([]string{"LessThan", "LessThanOrEqual", "GreaterThan", "GreaterThanOrEqual"}, false),
+							ValidateFunc: validateMonitorThresholdType,
 						},
 						"time_range": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringInSlice([]string{"5m", "-5m", "10m", "-10m", "15m", "-15m", "30m", "-30m", "60m", "-60m", "1h", "-1h", "3h", "-3h", "6h", "-6h", "12h", "-12h", "24h", "-24h", "1d", "-1d"}, false),
+							ValidateFunc: validateMonitorTimeRange,
 						},
 						"trigger_source": {
 							Type:         schema.TypeString,
@@ -126,6 +133,8 @@ func resourceSumologicMonitorsLibraryMonitor() *schema.Resource {
 				},
 			},
 
+			"trigger_conditions": triggerConditionsSchema(),
+
 			"notifications": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -134,8 +143,7 @@ func resourceSumologicMonitorsLibraryMonitor() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"notification": {
 							Type:     schema.TypeList,
-							Required: true,
-							MinItems: 1,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -174,13 +182,17 @@ func resourceSumologicMonitorsLibraryMonitor() *schema.Resource {
 										Type:     schema.TypeString,
 										Optional: true,
 									},
-									"payload_override": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
+									"payload_override": payloadOverrideSchema(),
 								},
 							},
 						},
+						"email":           emailNotificationSchema(),
+						"pagerduty":       connectionNotificationSchema("pagerduty"),
+						"opsgenie":        connectionNotificationSchema("opsgenie"),
+						"slack":           connectionNotificationSchema("slack"),
+						"microsoft_teams": connectionNotificationSchema("microsoft_teams"),
+						"jira":            connectionNotificationSchema("jira"),
+						"webhook":         connectionNotificationSchema("webhook"),
 						"run_for_trigger_types": {
 							Type:     schema.TypeList,
 							Required: true,
@@ -206,7 +218,12 @@ func resourceSumologicMonitorsLibraryMonitor() *schema.Resource {
 			"monitor_type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"Logs", "Metrics"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"Logs", "Metrics", "Slo"}, false),
+			},
+
+			"slo_id": {
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 
 			"is_locked": {
@@ -303,6 +320,7 @@ func resourceSumologicMonitorsLibraryMonitorRead(d *schema.ResourceData, meta in
 	d.Set("version", monitor.Version)
 	d.Set("description", monitor.Description)
 	d.Set("name", monitor.Name)
+	d.Set("type", monitor.Type)
 	d.Set("parent_id", monitor.ParentID)
 	d.Set("modified_at", monitor.ModifiedAt)
 	d.Set("content_type", monitor.ContentType)
@@ -311,6 +329,7 @@ func resourceSumologicMonitorsLibraryMonitorRead(d *schema.ResourceData, meta in
 	d.Set("is_disabled", monitor.IsDisabled)
 	d.Set("status", monitor.Status)
 	d.Set("group_notifications", monitor.GroupNotifications)
+	d.Set("slo_id", monitor.SloID)
 	// set notifications
 	notifications := make([]interface{}, len(monitor.Notifications))
 	for i, n := range monitor.Notifications {
@@ -331,6 +350,15 @@ func resourceSumologicMonitorsLibraryMonitorRead(d *schema.ResourceData, meta in
 				internalNotification["connection_type"] = "Webhook"
 			}
 		}
+		// typed notification blocks are only read back where they are
+		// configured, imported notifications use notification
+		if len(d.Get(fmt.Sprintf("notifications.%d.notification", i)).([]interface{})) == 0 && typedNotificationBlock(d, i) != "" {
+			if typed, ok := flattenTypedNotification(d, i, internalNotification["connection_type"].(string),
+				internalNotificationDict, n.RunForTriggerTypes); ok {
+				notifications[i] = typed
+				continue
+			}
+		}
 		if internalNotification["connection_type"].(string) == "Email" {
 			// for backwards compatibility
 			internalNotification["action_type"] = "EmailAction"
@@ -358,21 +386,31 @@ func resourceSumologicMonitorsLibraryMonitorRead(d *schema.ResourceData, meta in
 	if err := d.Set("notifications", notifications); err != nil {
 		return err
 	}
-	// set triggers
-	triggers := make([]interface{}, len(monitor.Triggers))
-	for i, t := range monitor.Triggers {
-		triggers[i] = map[string]interface{}{
-			"trigger_type":     t.TriggerType,
-			"threshold":        t.Threshold,
-			"threshold_type":   t.ThresholdType,
-			"time_range":       strings.TrimPrefix(t.TimeRange, "-"),
-			"occurrence_type":  t.OccurrenceType,
-			"trigger_source":   t.TriggerSource,
-			"detection_method": t.DetectionMethod,
+	// set triggers, as trigger_conditions unless they are configured as
+	// triggers
+	if len(d.Get("trigger_conditions").([]interface{})) > 0 ||
+		(len(d.Get("triggers").([]interface{})) == 0 && isTriggerConditions(monitor.Triggers)) {
+		if err := d.Set("trigger_conditions", flattenTriggerConditions(d, monitor.Triggers)); err != nil {
+			return err
 		}
-	}
-	if err := d.Set("triggers", triggers); err != nil {
-		return err
+		d.Set("triggers", nil)
+	} else {
+		triggers := make([]interface{}, len(monitor.Triggers))
+		for i, t := range monitor.Triggers {
+			triggers[i] = map[string]interface{}{
+				"trigger_type":     t.TriggerType,
+				"threshold":        t.threshold(),
+				"threshold_type":   t.ThresholdType,
+				"time_range":       strings.TrimPrefix(t.TimeRange, "-"),
+				"occurrence_type":  t.OccurrenceType,
+				"trigger_source":   t.TriggerSource,
+				"detection_method": t.DetectionMethod,
+			}
+		}
+		if err := d.Set("triggers", triggers); err != nil {
+			return err
+		}
+		d.Set("trigger_conditions", nil)
 	}
 	// set queries
 	queries := make([]interface{}, len(monitor.Queries))
@@ -424,6 +462,10 @@ func getNotifications(d *schema.ResourceData) []MonitorNotification {
 	notifications := make([]MonitorNotification, len(rawNotifications))
 	for i := range rawNotifications {
 		notificationDict := rawNotifications[i].(map[string]interface{})
+		if n, ok := expandTypedNotification(notificationDict); ok {
+			notifications[i] = n
+			continue
+		}
 		rawNotificationAction := notificationDict["notification"].([]interface{})
 		notificationActionDict := rawNotificationAction[0].(map[string]interface{})
 		connectionType := ""
@@ -470,19 +512,24 @@ func getNotifications(d *schema.ResourceData) []MonitorNotification {
 }
 
 func getTriggers(d *schema.ResourceData) []TriggerCondition {
+	if len(d.Get("trigger_conditions").([]interface{})) > 0 {
+		return getTriggerConditions(d)
+	}
 	rawTriggers := d.Get("triggers").([]interface{})
 	triggers := make([]TriggerCondition, len(rawTriggers))
 	for i := range rawTriggers {
 		triggerDict := rawTriggers[i].(map[string]interface{})
 		triggers[i] = TriggerCondition{
 			TriggerType:     triggerDict["trigger_type"].(string),
-			Threshold:       triggerDict["threshold"].(float64),
 			ThresholdType:   triggerDict["threshold_type"].(string),
 			TimeRange:       triggerDict["time_range"].(string),
 			OccurrenceType:  triggerDict["occurrence_type"].(string),
 			TriggerSource:   triggerDict["trigger_source"].(string),
 			DetectionMethod: triggerDict["detection_method"].(string),
 		}
+		if threshold := triggerDict["threshold"].(float64); threshold != 0 || triggers[i].ThresholdType != "" {
+			triggers[i].Threshold = &threshold
+		}
 	}
 	return triggers
 }
@@ -516,6 +563,7 @@ func resourceToMonitorsLibraryMonitor(d *schema.ResourceData) MonitorsLibraryMon
 		ID:                 d.Id(),
 		CreatedAt:          d.Get("created_at").(string),
 		MonitorType:        d.Get("monitor_type").(string),
+		SloID:              d.Get("slo_id").(string),
 		Description:        d.Get("description").(string),
 		Queries:            queries,
 		ModifiedBy:         d.Get("modified_by").(string),
@@ -0,0 +1,381 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// The typed notification blocks of a monitor model a notification per
+// connection type. The settings specific to a connection type are sent as
+// fields of the payload override, as the API only takes a connection and a
+// payload.
+
+// notificationPayloadField is a setting of a typed notification that is sent
+// as a field of the payload override.
+type notificationPayloadField struct {
+	attribute string
+	// path is the path of the field in the payload.
+	path     []string
+	validate schema.SchemaValidateFunc
+}
+
+type notificationConnection struct {
+	connectionType string
+	fields         []notificationPayloadField
+}
+
+// notificationBlocks are the typed notification blocks, email is the only
+// one that is not sent to a connection.
+var notificationBlocks = []string{"email", "pagerduty", "opsgenie", "slack", "microsoft_teams", "jira", "webhook"}
+
+var notificationConnections = map[string]notificationConnection{
+	"pagerduty": {"PagerDuty", []notificationPayloadField{
+		{"severity", []string{"payload", "severity"},
+			validation.StringInSlice([]string{"critical", "error", "warning", "info"}, false)},
+		{"dedup_key", []string{"dedup_key"}, nil},
+	}},
+	"opsgenie": {"Opsgenie", []notificationPayloadField{
+		{"priority", []string{"priority"}, validation.StringInSlice([]string{"P1", "P2", "P3", "P4", "P5"}, false)},
+	}},
+	"slack": {"Slack", []notificationPayloadField{
+		{"channel", []string{"channel"}, validation.StringMatch(regexp.MustCompile(`^[#@]\S+$`),
+			"must be a channel (#channel) or user (@user)")},
+	}},
+	"microsoft_teams": {"MicrosoftTeams", nil},
+	"jira": {"Jira", []notificationPayloadField{
+		{"project_key", []string{"fields", "project", "key"}, nil},
+		{"issue_type", []string{"fields", "issuetype", "name"}, nil},
+		{"priority", []string{"fields", "priority", "name"}, nil},
+	}},
+	"webhook": {"Webhook", nil},
+}
+
+func notifyOnResolvedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+func emailNotificationSchema() *schema.Schema {
+	return singleBlockSchema(map[string]*schema.Schema{
+		"recipients": {
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"subject": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"message_body": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"time_zone": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"notify_on_resolved": notifyOnResolvedSchema(),
+	})
+}
+
+func connectionNotificationSchema(block string) *schema.Schema {
+	fields := map[string]*schema.Schema{
+		"connection_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"payload_override":   payloadOverrideSchema(),
+		"notify_on_resolved": notifyOnResolvedSchema(),
+	}
+	for _, field := range notificationConnections[block].fields {
+		fields[field.attribute] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: field.validate,
+		}
+	}
+	return singleBlockSchema(fields)
+}
+
+func payloadOverrideSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: structure.SuppressJsonDiff,
+	}
+}
+
+// withResolvedTriggerTypes adds the Resolved trigger type of every alerting
+// trigger type.
+func withResolvedTriggerTypes(triggerTypes []interface{}) []interface{} {
+	present := map[interface{}]bool{}
+	for _, triggerType := range triggerTypes {
+		present[triggerType] = true
+	}
+	result := append([]interface{}{}, triggerTypes...)
+	for _, triggerType := range triggerTypes {
+		resolved := "Resolved" + triggerType.(string)
+		if !strings.HasPrefix(triggerType.(string), "Resolved") && !present[resolved] {
+			result = append(result, resolved)
+			present[resolved] = true
+		}
+	}
+	return result
+}
+
+// withoutResolvedTriggerTypes removes the Resolved trigger types
+// withResolvedTriggerTypes adds.
+func withoutResolvedTriggerTypes(triggerTypes []interface{}) []interface{} {
+	present := map[interface{}]bool{}
+	for _, triggerType := range triggerTypes {
+		present[triggerType] = true
+	}
+	var result []interface{}
+	for _, triggerType := range triggerTypes {
+		if alerting := strings.TrimPrefix(triggerType.(string), "Resolved"); alerting != triggerType && present[alerting] {
+			continue
+		}
+		result = append(result, triggerType)
+	}
+	return result
+}
+
+// expandTypedNotification returns the notification of a notifications block
+// if it uses a typed notification block.
+func expandTypedNotification(notificationDict map[string]interface{}) (MonitorNotification, bool) {
+	for _, block := range notificationBlocks {
+		if list, _ := notificationDict[block].([]interface{}); len(list) == 0 {
+			continue
+		}
+		settings := firstBlock(notificationDict[block])
+		if settings == nil {
+			settings = map[string]interface{}{}
+		}
+
+		var n MonitorNotification
+		n.RunForTriggerTypes = notificationDict["run_for_trigger_types"].([]interface{})
+		if notifyOnResolved, _ := settings["notify_on_resolved"].(bool); notifyOnResolved {
+			n.RunForTriggerTypes = withResolvedTriggerTypes(n.RunForTriggerTypes)
+		}
+
+		if block == "email" {
+			recipients, _ := settings["recipients"].([]interface{})
+			subject, _ := settings["subject"].(string)
+			messageBody, _ := settings["message_body"].(string)
+			timeZone, _ := settings["time_zone"].(string)
+			n.Notification = EmailNotification{
+				ActionType:     "EmailAction",
+				ConnectionType: "Email",
+				Subject:        subject,
+				Recipients:     recipients,
+				MessageBody:    messageBody,
+				TimeZone:       timeZone,
+			}
+			return n, true
+		}
+
+		connection := notificationConnections[block]
+		connectionID, _ := settings["connection_id"].(string)
+		n.Notification = WebhookNotificiation{
+			ActionType:      "NamedConnectionAction",
+			ConnectionType:  connection.connectionType,
+			ConnectionID:    connectionID,
+			PayloadOverride: expandNotificationPayload(settings, connection.fields),
+		}
+		return n, true
+	}
+	return MonitorNotification{}, false
+}
+
+// expandNotificationPayload sets the fields of the typed settings in the
+// payload override.
+func expandNotificationPayload(settings map[string]interface{}, fields []notificationPayloadField) string {
+	payloadOverride, _ := settings["payload_override"].(string)
+	payload := map[string]interface{}{}
+	if payloadOverride != "" {
+		if err := json.Unmarshal([]byte(payloadOverride), &payload); err != nil {
+			return payloadOverride
+		}
+	}
+
+	changed := false
+	for _, field := range fields {
+		value, _ := settings[field.attribute].(string)
+		if value == "" {
+			continue
+		}
+		parent := payload
+		for _, key := range field.path[:len(field.path)-1] {
+			child, ok := parent[key].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				parent[key] = child
+			}
+			parent = child
+		}
+		parent[field.path[len(field.path)-1]] = value
+		changed = true
+	}
+	if !changed {
+		return payloadOverride
+	}
+
+	rawPayload, _ := json.Marshal(payload)
+	return string(rawPayload)
+}
+
+// takePayloadField removes a string field from the payload and returns it,
+// along with the objects it leaves empty.
+func takePayloadField(payload map[string]interface{}, path []string) (string, bool) {
+	if len(path) == 1 {
+		value, ok := payload[path[0]].(string)
+		if ok {
+			delete(payload, path[0])
+		}
+		return value, ok
+	}
+	child, ok := payload[path[0]].(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	value, ok := takePayloadField(child, path[1:])
+	if ok && len(child) == 0 {
+		delete(payload, path[0])
+	}
+	return value, ok
+}
+
+// typedNotificationBlock returns the typed notification block set for the
+// notification at index in the state, if any.
+func typedNotificationBlock(d *schema.ResourceData, index int) string {
+	for _, block := range notificationBlocks {
+		if len(d.Get(fmt.Sprintf("notifications.%d.%s", index, block)).([]interface{})) > 0 {
+			return block
+		}
+	}
+	return ""
+}
+
+// flattenTypedNotification returns the typed notification block of the
+// connection type, keeping notify_on_resolved from the state.
+func flattenTypedNotification(d *schema.ResourceData, index int, connectionType string,
+	notification map[string]interface{}, runForTriggerTypes []interface{}) (map[string]interface{}, bool) {
+	block := ""
+	if connectionType == "Email" {
+		block = "email"
+	}
+	for name, connection := range notificationConnections {
+		if connection.connectionType == connectionType {
+			block = name
+		}
+	}
+	if block == "" {
+		return nil, false
+	}
+
+	notifyOnResolved := d.Get(fmt.Sprintf("notifications.%d.%s.0.notify_on_resolved", index, block)).(bool)
+	settings := map[string]interface{}{
+		"notify_on_resolved": notifyOnResolved,
+	}
+	if notifyOnResolved {
+		runForTriggerTypes = withoutResolvedTriggerTypes(runForTriggerTypes)
+	}
+
+	if block == "email" {
+		settings["recipients"] = notification["recipients"]
+		settings["subject"] = notification["subject"]
+		settings["message_body"] = notification["messageBody"]
+		settings["time_zone"] = notification["timeZone"]
+	} else {
+		settings["connection_id"] = notification["connectionId"]
+		payloadOverride, _ := notification["payloadOverride"].(string)
+		var payload map[string]interface{}
+		if payloadOverride != "" && json.Unmarshal([]byte(payloadOverride), &payload) == nil {
+			for _, field := range notificationConnections[block].fields {
+				if value, ok := takePayloadField(payload, field.path); ok {
+					settings[field.attribute] = value
+				}
+			}
+			payloadOverride = ""
+			if len(payload) > 0 {
+				rawPayload, _ := json.Marshal(payload)
+				payloadOverride = string(rawPayload)
+			}
+		}
+		settings["payload_override"] = payloadOverride
+	}
+
+	return map[string]interface{}{
+		block:                   []interface{}{settings},
+		"run_for_trigger_types": runForTriggerTypes,
+	}, true
+}
+
+// resourceSumologicMonitorsLibraryMonitorNotificationsCustomizeDiff requires
+// a single notification block per notification, and rejects payload
+// overrides that set the fields of typed settings and Resolved trigger types
+// that notify_on_resolved adds.
+func resourceSumologicMonitorsLibraryMonitorNotificationsCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("notifications") {
+		return nil
+	}
+	blocks := append([]string{"notification"}, notificationBlocks...)
+	for i, raw := range d.Get("notifications").([]interface{}) {
+		notification, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var set []string
+		for _, block := range blocks {
+			if list, _ := notification[block].([]interface{}); len(list) > 0 {
+				set = append(set, block)
+			}
+		}
+		if len(set) != 1 {
+			return fmt.Errorf("notifications.%d must contain exactly one of %s", i, strings.Join(blocks, ", "))
+		}
+
+		settings := firstBlock(notification[set[0]])
+		if settings == nil {
+			continue
+		}
+		// They could not be told apart from the added ones when reading.
+		if notifyOnResolved, _ := settings["notify_on_resolved"].(bool); notifyOnResolved {
+			triggerTypes, _ := notification["run_for_trigger_types"].([]interface{})
+			if withoutResolved := withoutResolvedTriggerTypes(triggerTypes); len(withoutResolved) != len(triggerTypes) {
+				return fmt.Errorf("notifications.%d.run_for_trigger_types must not contain the Resolved trigger "+
+					"types of its trigger types when notify_on_resolved is set, they are added", i)
+			}
+		}
+		connection, ok := notificationConnections[set[0]]
+		if !ok {
+			continue
+		}
+		payloadOverride, _ := settings["payload_override"].(string)
+		var payload map[string]interface{}
+		if payloadOverride == "" || json.Unmarshal([]byte(payloadOverride), &payload) != nil {
+			continue
+		}
+		for _, field := range connection.fields {
+			if _, ok := takePayloadField(payload, field.path); ok {
+				return fmt.Errorf("notifications.%d.%s.0.payload_override must not set %s, use %s instead",
+					i, set[0], strings.Join(field.path, "."), field.attribute)
+			}
+		}
+	}
+	return nil
+}
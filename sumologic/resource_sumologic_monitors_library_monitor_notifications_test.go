@@ -0,0 +1,155 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestUnitSumologicMonitorNotifications(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := func(pagerDuty, slack string) string {
+		return fmt.Sprintf(`
+resource "sumologic_monitor" "test" {
+	name = "test"
+	monitor_type = "Logs"
+	queries {
+		row_id = "A"
+		query = "_sourceCategory=app error"
+	}
+	trigger_conditions {
+		logs_static_condition {
+			critical {
+				time_range = "15m"
+				alert {
+					threshold = 40
+					threshold_type = "GreaterThan"
+				}
+			}
+		}
+	}
+	notifications {
+		pagerduty {
+			connection_id = "0000000000ABC123"
+			%s
+		}
+		run_for_trigger_types = ["Critical"]
+	}
+	notifications {
+		slack {
+			connection_id = "0000000000ABC456"
+			%s
+		}
+		run_for_trigger_types = ["Critical", "ResolvedCritical"]
+	}
+	notifications {
+		email {
+			recipients = ["oncall@example.com"]
+			subject = "{{TriggerType}} on {{Name}}"
+		}
+		run_for_trigger_types = ["Critical"]
+	}
+}`, pagerDuty, slack)
+	}
+	pagerDuty := `severity = "critical"
+			dedup_key = "{{Name}}"
+			notify_on_resolved = true
+			payload_override = %q`
+	monitorPath := func(attributes map[string]string) string {
+		return "v1/monitors/" + attributes["id"]
+	}
+	var path string
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config:      config(fmt.Sprintf(pagerDuty, `{"payload": {"summary": "{{Name}}"}}`), `channel = "alerts"`),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`must be a channel \(#channel\) or user \(@user\)`),
+		},
+		resource.TestStep{
+			Config:      config(fmt.Sprintf(pagerDuty, `{"dedup_key": "{{Id}}"}`), `channel = "#alerts"`),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("notifications.0.pagerduty.0.payload_override must not set dedup_key, use dedup_key instead"),
+		},
+		resource.TestStep{
+			Config:      config(fmt.Sprintf(pagerDuty, `{"payload": {"summary": "{{Name}}"}}`), "channel = \"#alerts\"\n\t\t\tnotify_on_resolved = true"),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("notifications.1.run_for_trigger_types must not contain the Resolved trigger types"),
+		},
+		resource.TestStep{
+			Config: strings.Replace(config(fmt.Sprintf(pagerDuty, `{"payload": {"summary": "{{Name}}"}}`), `channel = "#alerts"`),
+				`subject = "{{TriggerType}} on {{Name}}"
+		}
+		run_for_trigger_types = ["Critical"]`, `subject = "{{TriggerType}} on {{Name}}"
+			notify_on_resolved = true
+		}
+		run_for_trigger_types = ["Critical", "ResolvedCritical"]`, 1),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("notifications.2.run_for_trigger_types must not contain the Resolved trigger types"),
+		},
+		resource.TestStep{
+			Config: config(fmt.Sprintf(pagerDuty, `{"payload": {"summary": "{{Name}}"}}`),
+				"channel = \"#alerts\"\n\t\t}\n\t\temail {\n\t\t\trecipients = [\"a@example.com\"]\n\t\t\tsubject = \"s\""),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("notifications.1 must contain exactly one of notification, email, pagerduty"),
+		},
+		resource.TestStep{
+			Config: config(fmt.Sprintf(pagerDuty, `{"payload": {"summary": "{{Name}}"}}`), `channel = "#alerts"`),
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_monitor.test", monitorPath, map[string]interface{}{
+					"notifications": []interface{}{
+						map[string]interface{}{
+							"notification": map[string]interface{}{
+								"actionType": "NamedConnectionAction", "connectionType": "PagerDuty",
+								"connectionId":    "0000000000ABC123",
+								"payloadOverride": `{"dedup_key":"{{Name}}","payload":{"severity":"critical","summary":"{{Name}}"}}`,
+							},
+							"runForTriggerTypes": []interface{}{"Critical", "ResolvedCritical"},
+						},
+						map[string]interface{}{
+							"notification": map[string]interface{}{
+								"actionType": "NamedConnectionAction", "connectionType": "Slack",
+								"connectionId": "0000000000ABC456", "payloadOverride": `{"channel":"#alerts"}`,
+							},
+							"runForTriggerTypes": []interface{}{"Critical", "ResolvedCritical"},
+						},
+						map[string]interface{}{
+							"notification": map[string]interface{}{
+								"actionType": "EmailAction", "connectionType": "Email",
+								"recipients": []interface{}{"oncall@example.com"}, "subject": "{{TriggerType}} on {{Name}}",
+								"messageBody": "", "timeZone": "",
+							},
+							"runForTriggerTypes": []interface{}{"Critical"},
+						},
+					},
+				}),
+				resource.TestCheckResourceAttr("sumologic_monitor.test", "notifications.0.run_for_trigger_types.#", "1"),
+				resource.TestCheckResourceAttr("sumologic_monitor.test", "notifications.1.slack.0.payload_override", ""),
+				func(s *terraform.State) error {
+					path = monitorPath(s.RootModule().Resources["sumologic_monitor.test"].Primary.Attributes)
+					return nil
+				},
+			),
+		},
+		resource.TestStep{
+			Config:   config(fmt.Sprintf(pagerDuty, "{\n  \"payload\": {\n    \"summary\": \"{{Name}}\"\n  }\n}"), `channel = "#alerts"`),
+			PlanOnly: true,
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update(path, func(monitor map[string]interface{}) {
+					notification := monitor["notifications"].([]interface{})[0].(map[string]interface{})["notification"]
+					notification.(map[string]interface{})["payloadOverride"] =
+						`{"dedup_key":"{{Name}}","payload":{"severity":"warning","summary":"{{Name}}"}}`
+				})
+			},
+			Config:             config(fmt.Sprintf(pagerDuty, `{"payload": {"summary": "{{Name}}"}}`), `channel = "#alerts"`),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+	)
+}
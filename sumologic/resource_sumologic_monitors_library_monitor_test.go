@@ -75,10 +75,11 @@ func TestAccSumologicMonitorsLibraryMonitor_create(t *testing.T) {
 			Query: "_sourceCategory=monitor-manager error",
 		},
 	}
+	testThreshold := 40.0
 	testTriggers := []TriggerCondition{
 		{
 			ThresholdType:   "GreaterThan",
-			Threshold:       40.0,
+			Threshold:       &testThreshold,
 			TimeRange:       "15m",
 			OccurrenceType:  "ResultCount",
 			TriggerSource:   "AllResults",
@@ -87,7 +88,7 @@ func TestAccSumologicMonitorsLibraryMonitor_create(t *testing.T) {
 		},
 		{
 			ThresholdType:   "LessThanOrEqual",
-			Threshold:       40.0,
+			Threshold:       &testThreshold,
 			TimeRange:       "15m",
 			OccurrenceType:  "ResultCount",
 			TriggerSource:   "AllResults",
@@ -161,10 +162,11 @@ func TestAccSumologicMonitorsLibraryMonitor_update(t *testing.T) {
 			Query: "_sourceCategory=monitor-manager error",
 		},
 	}
+	testThreshold := 40.0
 	testTriggers := []TriggerCondition{
 		{
 			ThresholdType:   "GreaterThan",
-			Threshold:       40.0,
+			Threshold:       &testThreshold,
 			TimeRange:       "15m",
 			OccurrenceType:  "ResultCount",
 			TriggerSource:   "AllResults",
@@ -173,7 +175,7 @@ func TestAccSumologicMonitorsLibraryMonitor_update(t *testing.T) {
 		},
 		{
 			ThresholdType:   "LessThanOrEqual",
-			Threshold:       40.0,
+			Threshold:       &testThreshold,
 			TimeRange:       "15m",
 			OccurrenceType:  "ResultCount",
 			TriggerSource:   "AllResults",
@@ -221,7 +223,7 @@ func TestAccSumologicMonitorsLibraryMonitor_update(t *testing.T) {
 	testUpdatedTriggers := []TriggerCondition{
 		{
 			ThresholdType:   "GreaterThan",
-			Threshold:       40.0,
+			Threshold:       &testThreshold,
 			TimeRange:       "30m",
 			OccurrenceType:  "ResultCount",
 			TriggerSource:   "AllResults",
@@ -230,7 +232,7 @@ func TestAccSumologicMonitorsLibraryMonitor_update(t *testing.T) {
 		},
 		{
 			ThresholdType:   "LessThanOrEqual",
-			Threshold:       40.0,
+			Threshold:       &testThreshold,
 			TimeRange:       "30m",
 			OccurrenceType:  "ResultCount",
 			TriggerSource:   "AllResults",
@@ -454,3 +456,41 @@ resource "sumologic_monitor" "test" {
 	  }
 }`, testName)
 }
+
+func TestUnitSumologicMonitor(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	monitorPath := func(attributes map[string]string) string {
+		return "v1/monitors/" + attributes["id"]
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: testAccSumologicMonitorsLibraryMonitor("unit"),
+			Check: api.checkObject("sumologic_monitor.test", monitorPath, map[string]interface{}{
+				"name": "terraform_test_monitor_unit", "monitorType": "Logs", "isDisabled": false,
+				"parentId": fakeMonitorsRootID,
+			}),
+		},
+		resource.TestStep{
+			Config: testAccSumologicMonitorsLibraryMonitorUpdate("unit"),
+			Check: api.checkObject("sumologic_monitor.test", monitorPath, map[string]interface{}{
+				"isDisabled": true,
+			}),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_monitor.test",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update("v1/monitors/0000000000000001", func(monitor map[string]interface{}) {
+					monitor["isDisabled"] = false
+				})
+			},
+			Config:             testAccSumologicMonitorsLibraryMonitorUpdate("unit"),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+	)
+}
@@ -0,0 +1,537 @@
+package sumologic
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// trigger_conditions models the triggers of a monitor as a block per
+// detection method, each with the fields the detection method needs. The
+// Resolved triggers are derived from the alerting ones.
+
+// triggerConditionBlocks are the blocks of trigger_conditions, in the order
+// their triggers are sent.
+var triggerConditionBlocks = []string{
+	"logs_static_condition",
+	"metrics_static_condition",
+	"logs_outlier_condition",
+	"metrics_outlier_condition",
+	"logs_missing_data_condition",
+	"metrics_missing_data_condition",
+	"slo_burn_rate_condition",
+}
+
+var triggerConditionDetectionMethods = map[string]string{
+	"logs_static_condition":          "LogsStaticCondition",
+	"metrics_static_condition":       "MetricsStaticCondition",
+	"logs_outlier_condition":         "LogsOutlierCondition",
+	"metrics_outlier_condition":      "MetricsOutlierCondition",
+	"logs_missing_data_condition":    "LogsMissingDataCondition",
+	"metrics_missing_data_condition": "MetricsMissingDataCondition",
+	"slo_burn_rate_condition":        "SloBurnRateCondition",
+}
+
+// triggerConditionLevels maps the alerting blocks of a condition to their
+// trigger types.
+var triggerConditionLevels = map[string]string{
+	"critical": "Critical",
+	"warning":  "Warning",
+}
+
+// resolvedThresholdTypes are the threshold types that resolve a trigger of
+// the given threshold type at the same threshold.
+var resolvedThresholdTypes = map[string]string{
+	"GreaterThan":        "LessThanOrEqual",
+	"GreaterThanOrEqual": "LessThan",
+	"LessThan":           "GreaterThanOrEqual",
+	"LessThanOrEqual":    "GreaterThan",
+}
+
+// resolvedOccurrenceTypes are the occurrence types that resolve a metrics
+// trigger of the given occurrence type.
+var resolvedOccurrenceTypes = map[string]string{
+	"AtLeastOnce": "Always",
+	"Always":      "AtLeastOnce",
+}
+
+var validateMonitorTimeRange = validation.StringInSlice([]string{"5m", "-5m", "10m", "-10m", "15m", "-15m", "30m",
+	"-30m", "60m", "-60m", "1h", "-1h", "3h", "-3h", "6h", "-6h", "12h", "-12h", "24h", "-24h", "1d", "-1d"}, false)
+
+// validateTriggerConditionTimeRange takes the time ranges of trigger
+// conditions, which are read back without the legacy leading -.
+var validateTriggerConditionTimeRange = validation.StringInSlice([]string{"5m", "10m", "15m", "30m", "60m", "1h",
+	"3h", "6h", "12h", "24h", "1d"}, false)
+
+var validateMonitorThresholdType = validation.StringInSlice([]string{"LessThan", "LessThanOrEqual", "GreaterThan",
+	"GreaterThanOrEqual"}, false)
+
+func triggerConditionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"triggers"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"logs_static_condition": singleBlockSchema(map[string]*schema.Schema{
+					"critical": staticTriggerSchema(false),
+					"warning":  staticTriggerSchema(false),
+				}),
+				"metrics_static_condition": singleBlockSchema(map[string]*schema.Schema{
+					"critical": staticTriggerSchema(true),
+					"warning":  staticTriggerSchema(true),
+				}),
+				"logs_outlier_condition": singleBlockSchema(map[string]*schema.Schema{
+					"field": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"direction": outlierDirectionSchema(),
+					"critical":  logsOutlierTriggerSchema(),
+					"warning":   logsOutlierTriggerSchema(),
+				}),
+				"metrics_outlier_condition": singleBlockSchema(map[string]*schema.Schema{
+					"direction": outlierDirectionSchema(),
+					"critical":  metricsOutlierTriggerSchema(),
+					"warning":   metricsOutlierTriggerSchema(),
+				}),
+				"logs_missing_data_condition": singleBlockSchema(map[string]*schema.Schema{
+					"time_range": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateTriggerConditionTimeRange,
+					},
+				}),
+				"metrics_missing_data_condition": singleBlockSchema(map[string]*schema.Schema{
+					"time_range": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateTriggerConditionTimeRange,
+					},
+					"trigger_source": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"AllTimeSeries", "AnyTimeSeries"}, false),
+					},
+				}),
+				"slo_burn_rate_condition": singleBlockSchema(map[string]*schema.Schema{
+					"critical": burnRateTriggerSchema(),
+					"warning":  burnRateTriggerSchema(),
+				}),
+			},
+		},
+	}
+}
+
+// singleBlockSchema is the schema of an optional block with the fields that
+// can be set at most once.
+func singleBlockSchema(fields map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func thresholdSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"threshold": {
+					Type:     schema.TypeFloat,
+					Required: true,
+				},
+				"threshold_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateMonitorThresholdType,
+				},
+			},
+		},
+	}
+}
+
+func staticTriggerSchema(metrics bool) *schema.Schema {
+	resolution := thresholdSchema()
+	resolution.Required = false
+	resolution.Optional = true
+
+	trigger := singleBlockSchema(map[string]*schema.Schema{
+		"time_range": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateTriggerConditionTimeRange,
+		},
+		"alert":      thresholdSchema(),
+		"resolution": resolution,
+	})
+	if metrics {
+		trigger.Elem.(*schema.Resource).Schema["occurrence_type"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"AtLeastOnce", "Always"}, false),
+		}
+	}
+	return trigger
+}
+
+func outlierDirectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "Both",
+		ValidateFunc: validation.StringInSlice([]string{"Both", "Up", "Down"}, false),
+	}
+}
+
+func logsOutlierTriggerSchema() *schema.Schema {
+	return singleBlockSchema(map[string]*schema.Schema{
+		"window": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      5,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+		"consecutive": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+		"threshold": {
+			Type:     schema.TypeFloat,
+			Required: true,
+		},
+	})
+}
+
+func metricsOutlierTriggerSchema() *schema.Schema {
+	return singleBlockSchema(map[string]*schema.Schema{
+		"baseline_window": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateTriggerConditionTimeRange,
+		},
+		"threshold": {
+			Type:     schema.TypeFloat,
+			Required: true,
+		},
+	})
+}
+
+func burnRateTriggerSchema() *schema.Schema {
+	return singleBlockSchema(map[string]*schema.Schema{
+		"time_range": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateTriggerConditionTimeRange,
+		},
+		"burn_rate_threshold": {
+			Type:         schema.TypeFloat,
+			Required:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+	})
+}
+
+// firstBlock returns the only element of a block with MaxItems 1, or nil if
+// it is not set.
+func firstBlock(raw interface{}) map[string]interface{} {
+	if list, ok := raw.([]interface{}); ok && len(list) > 0 && list[0] != nil {
+		return list[0].(map[string]interface{})
+	}
+	return nil
+}
+
+func isMissingDataCondition(detectionMethod string) bool {
+	return strings.HasSuffix(detectionMethod, "MissingDataCondition")
+}
+
+func getTriggerConditions(d *schema.ResourceData) []TriggerCondition {
+	conditions := firstBlock(d.Get("trigger_conditions"))
+	var triggers []TriggerCondition
+	for _, block := range triggerConditionBlocks {
+		if condition := firstBlock(conditions[block]); condition != nil {
+			triggers = append(triggers, expandTriggerCondition(triggerConditionDetectionMethods[block], condition)...)
+		}
+	}
+	return triggers
+}
+
+// expandTriggerCondition returns the triggers of a condition, each alerting
+// trigger followed by the trigger that resolves it.
+func expandTriggerCondition(detectionMethod string, condition map[string]interface{}) []TriggerCondition {
+	if isMissingDataCondition(detectionMethod) {
+		trigger := TriggerCondition{
+			DetectionMethod: detectionMethod,
+			TriggerType:     "MissingData",
+			TimeRange:       condition["time_range"].(string),
+		}
+		if triggerSource, ok := condition["trigger_source"]; ok {
+			trigger.TriggerSource = triggerSource.(string)
+		}
+		resolved := trigger
+		resolved.TriggerType = "ResolvedMissingData"
+		return []TriggerCondition{trigger, resolved}
+	}
+
+	var triggers []TriggerCondition
+	for _, level := range []string{"critical", "warning"} {
+		settings := firstBlock(condition[level])
+		if settings == nil {
+			continue
+		}
+		trigger := TriggerCondition{
+			DetectionMethod: detectionMethod,
+			TriggerType:     triggerConditionLevels[level],
+		}
+		switch detectionMethod {
+		case "LogsStaticCondition", "MetricsStaticCondition":
+			alert := firstBlock(settings["alert"])
+			trigger.TimeRange = settings["time_range"].(string)
+			threshold := alert["threshold"].(float64)
+			trigger.Threshold = &threshold
+			trigger.ThresholdType = alert["threshold_type"].(string)
+			if detectionMethod == "LogsStaticCondition" {
+				trigger.OccurrenceType = "ResultCount"
+				trigger.TriggerSource = "AllResults"
+			} else {
+				trigger.OccurrenceType = settings["occurrence_type"].(string)
+				trigger.TriggerSource = "AnyTimeSeries"
+			}
+		case "LogsOutlierCondition":
+			trigger.Window = settings["window"].(int)
+			trigger.Consecutive = settings["consecutive"].(int)
+			threshold := settings["threshold"].(float64)
+			trigger.Threshold = &threshold
+			trigger.Direction = condition["direction"].(string)
+			trigger.Field = condition["field"].(string)
+		case "MetricsOutlierCondition":
+			trigger.BaselineWindow = settings["baseline_window"].(string)
+			threshold := settings["threshold"].(float64)
+			trigger.Threshold = &threshold
+			trigger.Direction = condition["direction"].(string)
+			trigger.TriggerSource = "AnyTimeSeries"
+		case "SloBurnRateCondition":
+			trigger.TimeRange = settings["time_range"].(string)
+			trigger.BurnRateThreshold = settings["burn_rate_threshold"].(float64)
+		}
+
+		resolved := trigger
+		resolved.TriggerType = "Resolved" + trigger.TriggerType
+		if trigger.ThresholdType != "" {
+			resolved.ThresholdType = resolvedThresholdTypes[trigger.ThresholdType]
+			if resolution := firstBlock(settings["resolution"]); resolution != nil {
+				threshold := resolution["threshold"].(float64)
+				resolved.Threshold = &threshold
+				resolved.ThresholdType = resolution["threshold_type"].(string)
+			}
+		}
+		if detectionMethod == "MetricsStaticCondition" {
+			resolved.OccurrenceType = resolvedOccurrenceTypes[trigger.OccurrenceType]
+		}
+		triggers = append(triggers, trigger, resolved)
+	}
+	return triggers
+}
+
+// isTriggerConditions returns whether all triggers have a detection method
+// trigger_conditions can express.
+func isTriggerConditions(triggers []TriggerCondition) bool {
+	for _, trigger := range triggers {
+		if !strings.HasSuffix(trigger.DetectionMethod, "Condition") || trigger.DetectionMethod == "StaticCondition" {
+			return false
+		}
+	}
+	return len(triggers) > 0
+}
+
+// flattenTriggerConditions groups the triggers by detection method. The
+// resolution of a static trigger is only set if it differs from the one
+// derived from the alert, or if it was configured.
+func flattenTriggerConditions(d *schema.ResourceData, triggers []TriggerCondition) []interface{} {
+	byMethod := map[string]map[string]TriggerCondition{}
+	for _, trigger := range triggers {
+		if byMethod[trigger.DetectionMethod] == nil {
+			byMethod[trigger.DetectionMethod] = map[string]TriggerCondition{}
+		}
+		byMethod[trigger.DetectionMethod][trigger.TriggerType] = trigger
+	}
+
+	conditions := map[string]interface{}{}
+	for _, block := range triggerConditionBlocks {
+		detectionMethod := triggerConditionDetectionMethods[block]
+		byType, ok := byMethod[detectionMethod]
+		if !ok {
+			continue
+		}
+
+		condition := map[string]interface{}{}
+		if isMissingDataCondition(detectionMethod) {
+			trigger := byType["MissingData"]
+			condition["time_range"] = strings.TrimPrefix(trigger.TimeRange, "-")
+			if detectionMethod == "MetricsMissingDataCondition" {
+				condition["trigger_source"] = trigger.TriggerSource
+			}
+			conditions[block] = []interface{}{condition}
+			continue
+		}
+
+		for level, triggerType := range triggerConditionLevels {
+			trigger, ok := byType[triggerType]
+			if !ok {
+				continue
+			}
+			settings := map[string]interface{}{}
+			switch detectionMethod {
+			case "LogsStaticCondition", "MetricsStaticCondition":
+				settings["time_range"] = strings.TrimPrefix(trigger.TimeRange, "-")
+				settings["alert"] = []interface{}{map[string]interface{}{
+					"threshold":      trigger.threshold(),
+					"threshold_type": trigger.ThresholdType,
+				}}
+				if detectionMethod == "MetricsStaticCondition" {
+					settings["occurrence_type"] = trigger.OccurrenceType
+				}
+				configured := fmt.Sprintf("trigger_conditions.0.%s.0.%s.0.resolution", block, level)
+				if resolved, ok := byType["Resolved"+triggerType]; ok && (len(d.Get(configured).([]interface{})) > 0 ||
+					resolved.threshold() != trigger.threshold() ||
+					resolved.ThresholdType != resolvedThresholdTypes[trigger.ThresholdType]) {
+					settings["resolution"] = []interface{}{map[string]interface{}{
+						"threshold":      resolved.threshold(),
+						"threshold_type": resolved.ThresholdType,
+					}}
+				}
+			case "LogsOutlierCondition":
+				settings["window"] = trigger.Window
+				settings["consecutive"] = trigger.Consecutive
+				settings["threshold"] = trigger.threshold()
+				condition["direction"] = trigger.Direction
+				condition["field"] = trigger.Field
+			case "MetricsOutlierCondition":
+				settings["baseline_window"] = strings.TrimPrefix(trigger.BaselineWindow, "-")
+				settings["threshold"] = trigger.threshold()
+				condition["direction"] = trigger.Direction
+			case "SloBurnRateCondition":
+				settings["time_range"] = strings.TrimPrefix(trigger.TimeRange, "-")
+				settings["burn_rate_threshold"] = trigger.BurnRateThreshold
+			}
+			condition[level] = []interface{}{settings}
+		}
+		conditions[block] = []interface{}{condition}
+	}
+	return []interface{}{conditions}
+}
+
+// resourceSumologicMonitorsLibraryMonitorCustomizeDiff rejects triggers the
+// monitor type does not support, and alerting triggers without a Resolved
+// trigger, at plan time. SLO monitors evaluate their SLO instead of queries.
+func resourceSumologicMonitorsLibraryMonitorCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("monitor_type") {
+		return nil
+	}
+	monitorType := d.Get("monitor_type").(string)
+
+	// An unknown slo_id is set, e.g. to the ID of an SLO to create.
+	sloIDSet := !d.NewValueKnown("slo_id") || d.Get("slo_id").(string) != ""
+	switch {
+	case monitorType == "Slo" && !sloIDSet:
+		return errors.New("slo_id is required for monitor type Slo")
+	case monitorType == "Slo" && len(d.Get("queries").([]interface{})) > 0:
+		return errors.New("queries are not supported for monitor type Slo, the SLO's queries are evaluated")
+	case monitorType != "Slo" && sloIDSet:
+		return fmt.Errorf("slo_id is only supported for monitor type Slo, not %s", monitorType)
+	}
+
+	if rawConditions := d.Get("trigger_conditions").([]interface{}); len(rawConditions) > 0 {
+		return validateTriggerConditions(d, monitorType, firstBlock(rawConditions))
+	}
+
+	if !d.NewValueKnown("triggers") {
+		return nil
+	}
+	triggerTypes := map[string]bool{}
+	for i, raw := range d.Get("triggers").([]interface{}) {
+		trigger, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		detectionMethod := trigger["detection_method"].(string)
+		if detectionMethod != "" && detectionMethod != "StaticCondition" &&
+			!strings.HasPrefix(detectionMethod, monitorType) {
+			return fmt.Errorf("triggers.%d.detection_method %s is not supported for monitor type %s",
+				i, detectionMethod, monitorType)
+		}
+		triggerTypes[trigger["trigger_type"].(string)] = true
+	}
+	for _, triggerType := range []string{"Critical", "Warning", "MissingData"} {
+		if triggerTypes[triggerType] && !triggerTypes["Resolved"+triggerType] {
+			return fmt.Errorf("triggers must contain a Resolved%s trigger for the %s trigger", triggerType, triggerType)
+		}
+	}
+	return nil
+}
+
+func validateTriggerConditions(d *schema.ResourceDiff, monitorType string, conditions map[string]interface{}) error {
+	var alerting []string
+	set := 0
+	for _, block := range triggerConditionBlocks {
+		if list, _ := conditions[block].([]interface{}); len(list) == 0 {
+			continue
+		}
+		// An empty block has no settings.
+		condition := firstBlock(conditions[block])
+		if condition == nil {
+			condition = map[string]interface{}{}
+		}
+		set++
+		detectionMethod := triggerConditionDetectionMethods[block]
+		if !strings.HasPrefix(detectionMethod, monitorType) {
+			return fmt.Errorf("trigger_conditions.0.%s is not supported for monitor type %s", block, monitorType)
+		}
+		if isMissingDataCondition(detectionMethod) {
+			continue
+		}
+		alerting = append(alerting, block)
+
+		if firstBlock(condition["critical"]) == nil && firstBlock(condition["warning"]) == nil {
+			return fmt.Errorf("trigger_conditions.0.%s must contain a critical or warning block", block)
+		}
+		for _, level := range []string{"critical", "warning"} {
+			settings := firstBlock(condition[level])
+			if settings == nil {
+				continue
+			}
+			alert := firstBlock(settings["alert"])
+			resolution := firstBlock(settings["resolution"])
+			if alert == nil || resolution == nil {
+				continue
+			}
+			alertType := alert["threshold_type"].(string)
+			resolutionType := resolution["threshold_type"].(string)
+			if alertType != "" && resolutionType != "" &&
+				strings.HasPrefix(alertType, "Greater") == strings.HasPrefix(resolutionType, "Greater") {
+				return fmt.Errorf("trigger_conditions.0.%s.0.%s.0.resolution.0.threshold_type %s does not resolve threshold_type %s",
+					block, level, resolutionType, alertType)
+			}
+		}
+	}
+
+	if len(alerting) > 1 {
+		return fmt.Errorf("trigger_conditions can only contain one of %s", strings.Join(alerting, ", "))
+	}
+	if set == 0 && d.NewValueKnown("trigger_conditions") {
+		return errors.New("trigger_conditions must contain a condition")
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestUnitSumologicMonitorTriggerConditions(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := func(logsConditions, metricsConditions string) string {
+		return fmt.Sprintf(`
+resource "sumologic_monitor" "logs" {
+	name = "logs"
+	monitor_type = "Logs"
+	queries {
+		row_id = "A"
+		query = "_sourceCategory=app error"
+	}
+	trigger_conditions {
+		%s
+	}
+}
+
+resource "sumologic_monitor" "metrics" {
+	name = "metrics"
+	monitor_type = "Metrics"
+	queries {
+		row_id = "A"
+		query = "metric=CPU_Idle"
+	}
+	trigger_conditions {
+		%s
+	}
+}`, logsConditions, metricsConditions)
+	}
+	logsStatic := `logs_static_condition {
+			critical {
+				time_range = "15m"
+				alert {
+					threshold = 40
+					threshold_type = "GreaterThan"
+				}
+			}
+			warning {
+				time_range = "15m"
+				alert {
+					threshold = 20
+					threshold_type = "GreaterThan"
+				}
+				resolution {
+					threshold = %s
+					threshold_type = "%s"
+				}
+			}
+		}
+		logs_missing_data_condition {
+			time_range = "30m"
+		}`
+	metricsStatic := `metrics_static_condition {
+			critical {
+				time_range = "5m"
+				occurrence_type = "AtLeastOnce"
+				alert {
+					threshold = 90
+					threshold_type = "GreaterThanOrEqual"
+				}
+			}
+		}`
+	metricsOutlier := `metrics_outlier_condition {
+			direction = "Up"
+			critical {
+				baseline_window = "1h"
+				threshold = 3.5
+			}
+		}
+		metrics_missing_data_condition {
+			time_range = "15m"
+			trigger_source = "AnyTimeSeries"
+		}`
+	monitorPath := func(attributes map[string]string) string {
+		return "v1/monitors/" + attributes["id"]
+	}
+	var logsMonitorPath string
+	checkTriggers := func(name string, expected ...string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			path := monitorPath(s.RootModule().Resources[name].Primary.Attributes)
+			if name == "sumologic_monitor.logs" {
+				logsMonitorPath = path
+			}
+			api.mu.Lock()
+			defer api.mu.Unlock()
+			triggers := api.objects[path]["triggers"].([]interface{})
+			if len(triggers) != len(expected) {
+				return fmt.Errorf("%s: expected %d triggers, got %v", path, len(expected), triggers)
+			}
+			for i, trigger := range triggers {
+				trigger := trigger.(map[string]interface{})
+				actual := fmt.Sprintf("%v %v %v %v", trigger["triggerType"], trigger["detectionMethod"],
+					trigger["threshold"], trigger["thresholdType"])
+				if actual != expected[i] {
+					return fmt.Errorf("%s: expected trigger %d to be %q, got %q", path, i, expected[i], actual)
+				}
+			}
+			return nil
+		}
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			// The legacy negative time ranges are only valid in triggers.
+			Config:      config(fmt.Sprintf(logsStatic, "15", "LessThan"), strings.Replace(metricsStatic, `"5m"`, `"-5m"`, 1)),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`expected trigger_conditions.0.metrics_static_condition.0.critical.0.time_range to be one of`),
+		},
+		resource.TestStep{
+			Config:      config(fmt.Sprintf(logsStatic, "15", "LessThan"), metricsStatic+"\n\t\tlogs_missing_data_condition {\n\t\t\ttime_range = \"15m\"\n\t\t}"),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("trigger_conditions.0.logs_missing_data_condition is not supported for monitor type Metrics"),
+		},
+		resource.TestStep{
+			Config:      config(fmt.Sprintf(logsStatic, "25", "GreaterThan"), metricsStatic),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("resolution.0.threshold_type GreaterThan does not resolve threshold_type GreaterThan"),
+		},
+		resource.TestStep{
+			Config:      config(fmt.Sprintf(logsStatic, "15", "LessThan"), metricsStatic+"\n\t\t"+metricsOutlier),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("trigger_conditions can only contain one of metrics_static_condition, metrics_outlier_condition"),
+		},
+		resource.TestStep{
+			Config:      config(fmt.Sprintf(logsStatic, "15", "LessThan"), "metrics_static_condition {}"),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("trigger_conditions.0.metrics_static_condition must contain a critical or warning block"),
+		},
+		resource.TestStep{
+			Config: config(fmt.Sprintf(logsStatic, "15", "LessThan"), metricsStatic),
+			Check: resource.ComposeTestCheckFunc(
+				checkTriggers("sumologic_monitor.logs",
+					"Critical LogsStaticCondition 40 GreaterThan",
+					"ResolvedCritical LogsStaticCondition 40 LessThanOrEqual",
+					"Warning LogsStaticCondition 20 GreaterThan",
+					"ResolvedWarning LogsStaticCondition 15 LessThan",
+					"MissingData LogsMissingDataCondition <nil> <nil>",
+					"ResolvedMissingData LogsMissingDataCondition <nil> <nil>"),
+				checkTriggers("sumologic_monitor.metrics",
+					"Critical MetricsStaticCondition 90 GreaterThanOrEqual",
+					"ResolvedCritical MetricsStaticCondition 90 LessThan"),
+				resource.TestCheckResourceAttr("sumologic_monitor.logs",
+					"trigger_conditions.0.logs_static_condition.0.critical.0.resolution.#", "0"),
+				resource.TestCheckResourceAttr("sumologic_monitor.logs", "triggers.#", "0"),
+			),
+		},
+		resource.TestStep{
+			// A threshold of 0 is sent.
+			Config: config(fmt.Sprintf(logsStatic, "0", "LessThanOrEqual"), metricsStatic),
+			Check: resource.ComposeTestCheckFunc(
+				checkTriggers("sumologic_monitor.logs",
+					"Critical LogsStaticCondition 40 GreaterThan",
+					"ResolvedCritical LogsStaticCondition 40 LessThanOrEqual",
+					"Warning LogsStaticCondition 20 GreaterThan",
+					"ResolvedWarning LogsStaticCondition 0 LessThanOrEqual",
+					"MissingData LogsMissingDataCondition <nil> <nil>",
+					"ResolvedMissingData LogsMissingDataCondition <nil> <nil>"),
+				resource.TestCheckResourceAttr("sumologic_monitor.logs",
+					"trigger_conditions.0.logs_static_condition.0.warning.0.resolution.0.threshold", "0"),
+			),
+		},
+		resource.TestStep{
+			Config: config(fmt.Sprintf(logsStatic, "15", "LessThan"), metricsOutlier),
+			Check: resource.ComposeTestCheckFunc(
+				checkTriggers("sumologic_monitor.metrics",
+					"Critical MetricsOutlierCondition 3.5 <nil>",
+					"ResolvedCritical MetricsOutlierCondition 3.5 <nil>",
+					"MissingData MetricsMissingDataCondition <nil> <nil>",
+					"ResolvedMissingData MetricsMissingDataCondition <nil> <nil>"),
+				api.checkObject("sumologic_monitor.metrics", monitorPath, map[string]interface{}{
+					"triggers": []interface{}{
+						map[string]interface{}{"triggerType": "Critical", "detectionMethod": "MetricsOutlierCondition",
+							"threshold": 3.5, "baselineWindow": "1h", "direction": "Up", "triggerSource": "AnyTimeSeries"},
+						map[string]interface{}{"triggerType": "ResolvedCritical", "detectionMethod": "MetricsOutlierCondition",
+							"threshold": 3.5, "baselineWindow": "1h", "direction": "Up", "triggerSource": "AnyTimeSeries"},
+						map[string]interface{}{"triggerType": "MissingData", "detectionMethod": "MetricsMissingDataCondition",
+							"timeRange": "15m", "triggerSource": "AnyTimeSeries"},
+						map[string]interface{}{"triggerType": "ResolvedMissingData", "detectionMethod": "MetricsMissingDataCondition",
+							"timeRange": "15m", "triggerSource": "AnyTimeSeries"},
+					},
+				}),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_monitor.logs",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update(logsMonitorPath, func(monitor map[string]interface{}) {
+					resolved := monitor["triggers"].([]interface{})[1].(map[string]interface{})
+					resolved["threshold"] = 35
+				})
+			},
+			Config:             config(fmt.Sprintf(logsStatic, "15", "LessThan"), metricsOutlier),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+	)
+}
+
+func TestUnitSumologicMonitorTriggersValidation(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := func(trigger string) string {
+		return fmt.Sprintf(`
+resource "sumologic_monitor" "test" {
+	name = "test"
+	monitor_type = "Logs"
+	queries {
+		row_id = "A"
+		query = "_sourceCategory=app error"
+	}
+	triggers {
+		threshold_type = "GreaterThan"
+		threshold = 40.0
+		time_range = "15m"
+		occurrence_type = "ResultCount"
+		trigger_source = "AllResults"
+		trigger_type = "Critical"
+		detection_method = "%s"
+	}
+}`, trigger)
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config:      config("MetricsStaticCondition"),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("triggers.0.detection_method MetricsStaticCondition is not supported for monitor type Logs"),
+		},
+		resource.TestStep{
+			Config:      config("LogsStaticCondition"),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("triggers must contain a ResolvedCritical trigger for the Critical trigger"),
+		},
+	)
+}
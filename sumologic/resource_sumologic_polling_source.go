@@ -26,14 +26,8 @@ func resourceSumologicPollingSource() *schema.Resource {
 		ForceNew:     true,
 		ValidateFunc: validation.StringInSlice([]string{"AwsS3Bucket", "AwsElbBucket", "AwsCloudFrontBucket", "AwsCloudTrailBucket", "AwsS3AuditBucket", "AwsCloudWatch"}, false),
 	}
-	pollingSource.Schema["scan_interval"] = &schema.Schema{
-		Type:     schema.TypeInt,
-		Required: true,
-	}
-	pollingSource.Schema["paused"] = &schema.Schema{
-		Type:     schema.TypeBool,
-		Required: true,
-	}
+	pollingSource.Schema["scan_interval"] = sourceScanIntervalSchema()
+	pollingSource.Schema["paused"] = sourcePausedSchema()
 	pollingSource.Schema["url"] = &schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
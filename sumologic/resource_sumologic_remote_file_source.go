@@ -0,0 +1,182 @@
+package sumologic
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSumologicRemoteFileSource() *schema.Resource {
+	remoteFileSource := resourceSumologicSource()
+	remoteFileSource.Create = resourceSumologicRemoteFileSourceCreate
+	remoteFileSource.Read = resourceSumologicRemoteFileSourceRead
+	remoteFileSource.Update = resourceSumologicRemoteFileSourceUpdate
+	remoteFileSource.Importer = &schema.ResourceImporter{
+		State: resourceSumologicSourceImport,
+	}
+
+	remoteFileSource.Schema["remote_hosts"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	remoteFileSource.Schema["remote_port"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      22,
+		ValidateFunc: validation.IntBetween(1, 65535),
+	}
+	remoteFileSource.Schema["remote_user"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	remoteFileSource.Schema["auth_method"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice([]string{"key", "password"}, false),
+	}
+	remoteFileSource.Schema["remote_password"] = &schema.Schema{
+		Type:      schema.TypeString,
+		Optional:  true,
+		Sensitive: true,
+	}
+	remoteFileSource.Schema["key_path"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	remoteFileSource.Schema["key_password"] = &schema.Schema{
+		Type:      schema.TypeString,
+		Optional:  true,
+		Sensitive: true,
+	}
+	remoteFileSource.Schema["path_expressions"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	remoteFileSource.Schema["denylist"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return remoteFileSource
+}
+
+func resourceSumologicRemoteFileSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	if d.Id() == "" {
+		source, err := resourceToRemoteFileSource(d)
+		if err != nil {
+			return err
+		}
+
+		id, err := c.CreateRemoteFileSource(source, d.Get("collector_id").(int))
+
+		if err != nil {
+			return err
+		}
+
+		d.SetId(strconv.Itoa(id))
+	}
+
+	return resourceSumologicRemoteFileSourceRead(d, meta)
+}
+
+func resourceSumologicRemoteFileSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	source, err := resourceToRemoteFileSource(d)
+	if err != nil {
+		return err
+	}
+
+	err = c.UpdateRemoteFileSource(source, d.Get("collector_id").(int))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceSumologicRemoteFileSourceRead(d, meta)
+}
+
+func resourceToRemoteFileSource(d *schema.ResourceData) (RemoteFileSource, error) {
+	source := resourceToSource(d)
+	source.Type = "RemoteFileV2"
+
+	remoteFileSource := RemoteFileSource{
+		Source:      source,
+		RemoteHosts: getStringList(d, "remote_hosts"),
+		RemotePort:  d.Get("remote_port").(int),
+		RemoteUser:  d.Get("remote_user").(string),
+		AuthMethod:  d.Get("auth_method").(string),
+		RemotePaths: getStringList(d, "path_expressions"),
+		Denylist:    getStringList(d, "denylist"),
+	}
+
+	switch remoteFileSource.AuthMethod {
+	case "password":
+		remoteFileSource.RemotePassword = d.Get("remote_password").(string)
+		if remoteFileSource.RemotePassword == "" {
+			return remoteFileSource, errors.New("remote_password must be set when auth_method is password")
+		}
+	case "key":
+		remoteFileSource.KeyPath = d.Get("key_path").(string)
+		remoteFileSource.KeyPassword = d.Get("key_password").(string)
+		if remoteFileSource.KeyPath == "" {
+			return remoteFileSource, errors.New("key_path must be set when auth_method is key")
+		}
+	}
+
+	return remoteFileSource, nil
+}
+
+func resourceSumologicRemoteFileSourceRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id, _ := strconv.Atoi(d.Id())
+	source, err := c.GetRemoteFileSource(d.Get("collector_id").(int), id)
+	if err != nil {
+		return err
+	}
+
+	if source == nil {
+		log.Printf("[WARN] Remote file source not found, removing from state: %v - %v", id, err)
+		d.SetId("")
+
+		return nil
+	}
+
+	if err := resourceSumologicSourceRead(d, source.Source); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	if err := d.Set("remote_hosts", source.RemoteHosts); err != nil {
+		return fmt.Errorf("error setting remote hosts for resource %s: %s", d.Id(), err)
+	}
+	d.Set("remote_port", source.RemotePort)
+	d.Set("remote_user", source.RemoteUser)
+	d.Set("auth_method", source.AuthMethod)
+	d.Set("key_path", source.KeyPath)
+	// The passwords are not returned by the API and are kept as configured.
+	if err := d.Set("path_expressions", source.RemotePaths); err != nil {
+		return fmt.Errorf("error setting path expressions for resource %s: %s", d.Id(), err)
+	}
+	if err := d.Set("denylist", source.Denylist); err != nil {
+		return fmt.Errorf("error setting denylist for resource %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
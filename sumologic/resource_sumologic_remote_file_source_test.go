@@ -0,0 +1,52 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitSumologicRemoteFileSource(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	api.seed("v1/collectors/42", map[string]interface{}{
+		"id": 42, "name": "host-collector", "collectorType": "Installable",
+	})
+	config := func(authMethod string) string {
+		return fmt.Sprintf(`
+resource "sumologic_remote_file_source" "test" {
+	collector_id = 42
+	name = "remote"
+	remote_hosts = ["10.0.0.1", "10.0.0.2"]
+	remote_user = "sumo"
+	auth_method = "%s"
+	key_path = "/home/sumo/.ssh/id_rsa"
+	path_expressions = ["/var/log/app/*.log"]
+}`, authMethod)
+	}
+	sourcePath := func(attributes map[string]string) string {
+		return fmt.Sprintf("v1/collectors/%s/sources/%s", attributes["collector_id"], attributes["id"])
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config:      config("password"),
+			ExpectError: regexp.MustCompile("remote_password must be set"),
+		},
+		resource.TestStep{
+			Config: config("key"),
+			Check: api.checkObject("sumologic_remote_file_source.test", sourcePath, map[string]interface{}{
+				"sourceType": "RemoteFileV2", "remotePort": float64(22), "authMethod": "key",
+				"keyPath": "/home/sumo/.ssh/id_rsa",
+			}),
+		},
+		resource.TestStep{
+			ResourceName:            "sumologic_remote_file_source.test",
+			ImportState:             true,
+			ImportStateIdFunc:       testImportStateIdFunc("sumologic_remote_file_source.test", "collector_id", "id"),
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"remote_password", "key_password"},
+		},
+	)
+}
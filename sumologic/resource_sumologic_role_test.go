@@ -199,3 +199,35 @@ func testAccCheckRoleAttributes(name string) resource.TestCheckFunc {
 		return f(s)
 	}
 }
+
+func TestUnitSumologicRole(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := testAccSumologicRole("Role", "Description", "_sourceCategory=prod", []string{`"viewCollectors"`})
+	rolePath := func(attributes map[string]string) string {
+		return "v1/roles/" + attributes["id"]
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: config,
+			Check: api.checkObject("sumologic_role.test", rolePath, map[string]interface{}{
+				"name": "Role", "filterPredicate": "_sourceCategory=prod", "capabilities": []string{"viewCollectors"},
+			}),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_role.test",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update("v1/roles/0000000000000001", func(role map[string]interface{}) {
+					role["capabilities"] = []interface{}{"manageCollectors"}
+				})
+			},
+			Config:             config,
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+	)
+}
@@ -0,0 +1,136 @@
+package sumologic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSumologicScriptSource() *schema.Resource {
+	scriptSource := resourceSumologicSource()
+	scriptSource.Create = resourceSumologicScriptSourceCreate
+	scriptSource.Read = resourceSumologicScriptSourceRead
+	scriptSource.Update = resourceSumologicScriptSourceUpdate
+	scriptSource.Importer = &schema.ResourceImporter{
+		State: resourceSumologicSourceImport,
+	}
+
+	scriptSource.Schema["commands"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	scriptSource.Schema["file"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"script"},
+	}
+	scriptSource.Schema["script"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"file"},
+	}
+	scriptSource.Schema["working_dir"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	// The timeout is in milliseconds, 0 lets the script run until it exits.
+	scriptSource.Schema["timeout"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+	scriptSource.Schema["cron_expression"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	return scriptSource
+}
+
+func resourceSumologicScriptSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	if d.Id() == "" {
+		source := resourceToScriptSource(d)
+
+		id, err := c.CreateScriptSource(source, d.Get("collector_id").(int))
+
+		if err != nil {
+			return err
+		}
+
+		d.SetId(strconv.Itoa(id))
+	}
+
+	return resourceSumologicScriptSourceRead(d, meta)
+}
+
+func resourceSumologicScriptSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	source := resourceToScriptSource(d)
+
+	err := c.UpdateScriptSource(source, d.Get("collector_id").(int))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceSumologicScriptSourceRead(d, meta)
+}
+
+func resourceToScriptSource(d *schema.ResourceData) ScriptSource {
+	source := resourceToSource(d)
+	source.Type = "Script"
+
+	scriptSource := ScriptSource{
+		Source:         source,
+		Commands:       getStringList(d, "commands"),
+		File:           d.Get("file").(string),
+		Script:         d.Get("script").(string),
+		WorkingDir:     d.Get("working_dir").(string),
+		Timeout:        d.Get("timeout").(int),
+		CronExpression: d.Get("cron_expression").(string),
+	}
+
+	return scriptSource
+}
+
+func resourceSumologicScriptSourceRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id, _ := strconv.Atoi(d.Id())
+	source, err := c.GetScriptSource(d.Get("collector_id").(int), id)
+	if err != nil {
+		return err
+	}
+
+	if source == nil {
+		log.Printf("[WARN] Script source not found, removing from state: %v - %v", id, err)
+		d.SetId("")
+
+		return nil
+	}
+
+	if err := resourceSumologicSourceRead(d, source.Source); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	if err := d.Set("commands", source.Commands); err != nil {
+		return fmt.Errorf("error setting commands for resource %s: %s", d.Id(), err)
+	}
+	d.Set("file", source.File)
+	d.Set("script", source.Script)
+	d.Set("working_dir", source.WorkingDir)
+	d.Set("timeout", source.Timeout)
+	d.Set("cron_expression", source.CronExpression)
+
+	return nil
+}
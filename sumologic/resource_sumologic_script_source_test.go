@@ -0,0 +1,42 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitSumologicScriptSource(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	api.seed("v1/collectors/42", map[string]interface{}{
+		"id": 42, "name": "host-collector", "collectorType": "Installable",
+	})
+	config := `
+resource "sumologic_script_source" "test" {
+	collector_id = 42
+	name = "script"
+	commands = ["/bin/bash"]
+	script = "uptime"
+	cron_expression = "0 0/5 * 1/1 * ? *"
+	timeout = 60000
+}`
+	sourcePath := func(attributes map[string]string) string {
+		return fmt.Sprintf("v1/collectors/%s/sources/%s", attributes["collector_id"], attributes["id"])
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: config,
+			Check: api.checkObject("sumologic_script_source.test", sourcePath, map[string]interface{}{
+				"sourceType": "Script", "script": "uptime", "timeout": float64(60000),
+			}),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_script_source.test",
+			ImportState:       true,
+			ImportStateIdFunc: testImportStateIdFunc("sumologic_script_source.test", "collector_id", "id"),
+			ImportStateVerify: true,
+		},
+	)
+}
@@ -0,0 +1,148 @@
+package sumologic
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceSumologicSlosLibraryFolder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSumologicSlosLibraryFolderCreate,
+		Read:   resourceSumologicSlosLibraryFolderRead,
+		Update: resourceSumologicSlosLibraryFolderUpdate,
+		Delete: resourceSumologicSlosLibraryFolderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: slosLibraryItemSchema(map[string]*schema.Schema{}),
+	}
+}
+
+// slosLibraryItemSchema adds the attributes common to the folders and SLOs of
+// the SLO library to the schema.
+func slosLibraryItemSchema(fields map[string]*schema.Schema) map[string]*schema.Schema {
+	fields["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	fields["description"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	// The root folder by default.
+	fields["parent_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	for _, attribute := range []string{"created_at", "created_by", "modified_at", "modified_by"} {
+		fields[attribute] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+	for _, attribute := range []string{"is_system", "is_mutable", "is_locked"} {
+		fields[attribute] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		}
+	}
+	fields["version"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+	return fields
+}
+
+// slosLibraryParentID returns the parent folder of an item of the SLO
+// library, the root folder if it is not set.
+func slosLibraryParentID(c *Client, d *schema.ResourceData) (string, error) {
+	if parentID := d.Get("parent_id").(string); parentID != "" {
+		return parentID, nil
+	}
+	rootFolder, err := c.GetSlosLibraryFolder("root")
+	if err != nil {
+		return "", err
+	}
+	return rootFolder.ID, nil
+}
+
+func resourceSumologicSlosLibraryFolderCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	folder := resourceToSlosLibraryFolder(d)
+	parentID, err := slosLibraryParentID(c, d)
+	if err != nil {
+		return err
+	}
+	id, err := c.CreateSlosLibraryFolder(folder, parentID)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	return resourceSumologicSlosLibraryFolderRead(d, meta)
+}
+
+func resourceSumologicSlosLibraryFolderRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	folder, err := c.GetSlosLibraryFolder(d.Id())
+	if err != nil {
+		return err
+	}
+	if folder == nil {
+		log.Printf("[WARN] SLO folder not found, removing from state: %v", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", folder.Name)
+	d.Set("description", folder.Description)
+	d.Set("parent_id", folder.ParentID)
+	d.Set("created_at", folder.CreatedAt)
+	d.Set("created_by", folder.CreatedBy)
+	d.Set("modified_at", folder.ModifiedAt)
+	d.Set("modified_by", folder.ModifiedBy)
+	d.Set("is_system", folder.IsSystem)
+	d.Set("is_mutable", folder.IsMutable)
+	d.Set("is_locked", folder.IsLocked)
+	d.Set("version", folder.Version)
+
+	return nil
+}
+
+func resourceSumologicSlosLibraryFolderUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	folder := resourceToSlosLibraryFolder(d)
+	folder.Type = "SlosLibraryFolderUpdate"
+	parentID, err := slosLibraryParentID(c, d)
+	if err != nil {
+		return err
+	}
+	folder.ParentID = parentID
+	if err := c.UpdateSlosLibraryFolder(folder); err != nil {
+		return err
+	}
+	return resourceSumologicSlosLibraryFolderRead(d, meta)
+}
+
+func resourceSumologicSlosLibraryFolderDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+	return c.DeleteSlosLibraryFolder(d.Id())
+}
+
+func resourceToSlosLibraryFolder(d *schema.ResourceData) SlosLibraryFolder {
+	return SlosLibraryFolder{
+		ID:          d.Id(),
+		Type:        "SlosLibraryFolder",
+		ContentType: "Folder",
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ParentID:    d.Get("parent_id").(string),
+		Version:     d.Get("version").(int),
+	}
+}
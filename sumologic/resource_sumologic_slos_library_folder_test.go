@@ -0,0 +1,50 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitSumologicSloFolder(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := func(description string) string {
+		return fmt.Sprintf(`
+resource "sumologic_slo_folder" "parent" {
+	name = "Reliability"
+}
+
+resource "sumologic_slo_folder" "test" {
+	name = "Auth"
+	description = "%s"
+	parent_id = sumologic_slo_folder.parent.id
+}`, description)
+	}
+	folderPath := func(attributes map[string]string) string {
+		return "v1/slos/" + attributes["id"]
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: config("First"),
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_slo_folder.parent", folderPath, map[string]interface{}{
+					"name": "Reliability", "type": "SlosLibraryFolder", "parentId": fakeSlosRootID,
+				}),
+				resource.TestCheckResourceAttrPair("sumologic_slo_folder.test", "parent_id", "sumologic_slo_folder.parent", "id"),
+			),
+		},
+		resource.TestStep{
+			Config: config("Second"),
+			Check: api.checkObject("sumologic_slo_folder.test", folderPath, map[string]interface{}{
+				"description": "Second", "type": "SlosLibraryFolder",
+			}),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_slo_folder.test",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	)
+}
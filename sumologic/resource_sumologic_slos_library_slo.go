@@ -0,0 +1,423 @@
+package sumologic
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// sloEvaluationBlocks are the indicator blocks by evaluation type. Window
+// based SLIs compare an aggregate of each window with a threshold, request
+// based SLIs divide the count of successful or unsuccessful requests by the
+// total count.
+var sloEvaluationBlocks = map[string]string{
+	"Window":  "window_based_evaluation",
+	"Request": "request_based_evaluation",
+}
+
+var sloCalendarPeriods = []string{"Week", "Month", "Quarter"}
+
+// sloRollingPeriodPattern matches rolling compliance periods, 1d to 90d.
+var sloRollingPeriodPattern = regexp.MustCompile(`^([1-9]|[1-8][0-9]|90)d$`)
+
+func resourceSumologicSlosLibrarySlo() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSumologicSlosLibrarySloCreate,
+		Read:   resourceSumologicSlosLibrarySloRead,
+		Update: resourceSumologicSlosLibrarySloUpdate,
+		Delete: resourceSumologicSlosLibrarySloDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceSumologicSlosLibrarySloCustomizeDiff,
+
+		Schema: slosLibraryItemSchema(map[string]*schema.Schema{
+			"signal_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Latency", "Error", "Throughput", "Availability", "Other"}, false),
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"application": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"compliance": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compliance_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Rolling", "Calendar"}, false),
+						},
+						"size": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"target": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+						"timezone": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateTimeZone,
+						},
+					},
+				},
+			},
+			"indicator": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"window_based_evaluation": sloEvaluationSchema(map[string]*schema.Schema{
+							"threshold": {
+								Type:     schema.TypeFloat,
+								Required: true,
+							},
+							"op": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateMonitorThresholdType,
+							},
+							"aggregation": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"Avg", "Min", "Max", "Sum"}, false),
+							},
+							"size": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9]|[1-5][0-9]|60)m$`),
+									"must be a number of minutes between 1m and 60m"),
+							},
+						}),
+						"request_based_evaluation": sloEvaluationSchema(map[string]*schema.Schema{}),
+					},
+				},
+			},
+		}),
+	}
+}
+
+func sloEvaluationSchema(fields map[string]*schema.Schema) *schema.Schema {
+	fields["query_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice([]string{"Logs", "Metrics"}, false),
+	}
+	fields["queries"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"query_group_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"Successful", "Unsuccessful", "Total", "Threshold"}, false),
+				},
+				"query_group": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"row_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"query": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"use_row_count": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+							"field": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	evaluation := singleBlockSchema(fields)
+	evaluation.ExactlyOneOf = []string{"indicator.0.window_based_evaluation", "indicator.0.request_based_evaluation"}
+	return evaluation
+}
+
+func resourceSumologicSlosLibrarySloCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	slo := resourceToSlosLibrarySlo(d)
+	parentID, err := slosLibraryParentID(c, d)
+	if err != nil {
+		return err
+	}
+	id, err := c.CreateSlosLibrarySlo(slo, parentID)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	return resourceSumologicSlosLibrarySloRead(d, meta)
+}
+
+func resourceSumologicSlosLibrarySloRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	slo, err := c.GetSlosLibrarySlo(d.Id())
+	if err != nil {
+		return err
+	}
+	if slo == nil {
+		log.Printf("[WARN] SLO not found, removing from state: %v", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", slo.Name)
+	d.Set("description", slo.Description)
+	d.Set("parent_id", slo.ParentID)
+	d.Set("created_at", slo.CreatedAt)
+	d.Set("created_by", slo.CreatedBy)
+	d.Set("modified_at", slo.ModifiedAt)
+	d.Set("modified_by", slo.ModifiedBy)
+	d.Set("is_system", slo.IsSystem)
+	d.Set("is_mutable", slo.IsMutable)
+	d.Set("is_locked", slo.IsLocked)
+	d.Set("version", slo.Version)
+	d.Set("signal_type", slo.SignalType)
+	d.Set("service", slo.Service)
+	d.Set("application", slo.Application)
+
+	compliance := map[string]interface{}{
+		"compliance_type": slo.Compliance.ComplianceType,
+		"size":            slo.Compliance.Size,
+		"target":          slo.Compliance.Target,
+		"timezone":        slo.Compliance.TimeZone,
+	}
+	if err := d.Set("compliance", []interface{}{compliance}); err != nil {
+		return err
+	}
+	if err := d.Set("indicator", flattenSloIndicator(slo.Indicator)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceSumologicSlosLibrarySloUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	slo := resourceToSlosLibrarySlo(d)
+	slo.Type = "SlosLibrarySloUpdate"
+	parentID, err := slosLibraryParentID(c, d)
+	if err != nil {
+		return err
+	}
+	slo.ParentID = parentID
+	if err := c.UpdateSlosLibrarySlo(slo); err != nil {
+		return err
+	}
+	return resourceSumologicSlosLibrarySloRead(d, meta)
+}
+
+func resourceSumologicSlosLibrarySloDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+	return c.DeleteSlosLibrarySlo(d.Id())
+}
+
+func resourceToSlosLibrarySlo(d *schema.ResourceData) SlosLibrarySlo {
+	compliance := firstBlock(d.Get("compliance"))
+	return SlosLibrarySlo{
+		ID:          d.Id(),
+		Type:        "SlosLibrarySlo",
+		ContentType: "Slo",
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ParentID:    d.Get("parent_id").(string),
+		Version:     d.Get("version").(int),
+		SignalType:  d.Get("signal_type").(string),
+		Service:     d.Get("service").(string),
+		Application: d.Get("application").(string),
+		Compliance: SloCompliance{
+			ComplianceType: compliance["compliance_type"].(string),
+			Size:           compliance["size"].(string),
+			Target:         compliance["target"].(float64),
+			TimeZone:       compliance["timezone"].(string),
+		},
+		Indicator: expandSloIndicator(firstBlock(d.Get("indicator"))),
+	}
+}
+
+func expandSloIndicator(indicator map[string]interface{}) SloIndicator {
+	var result SloIndicator
+	for evaluationType, block := range sloEvaluationBlocks {
+		evaluation := firstBlock(indicator[block])
+		if evaluation == nil {
+			continue
+		}
+		result.EvaluationType = evaluationType
+		result.QueryType = evaluation["query_type"].(string)
+		for _, raw := range evaluation["queries"].([]interface{}) {
+			queries := raw.(map[string]interface{})
+			group := SloQueryGroup{QueryGroupType: queries["query_group_type"].(string)}
+			for _, rawQuery := range queries["query_group"].([]interface{}) {
+				query := rawQuery.(map[string]interface{})
+				group.QueryGroup = append(group.QueryGroup, SloQuery{
+					RowID:       query["row_id"].(string),
+					Query:       query["query"].(string),
+					UseRowCount: query["use_row_count"].(bool),
+					Field:       query["field"].(string),
+				})
+			}
+			result.Queries = append(result.Queries, group)
+		}
+		if evaluationType == "Window" {
+			result.Threshold = evaluation["threshold"].(float64)
+			result.Op = evaluation["op"].(string)
+			result.Aggregation = evaluation["aggregation"].(string)
+			result.Size = evaluation["size"].(string)
+		}
+	}
+	return result
+}
+
+func flattenSloIndicator(indicator SloIndicator) []interface{} {
+	queries := make([]interface{}, len(indicator.Queries))
+	for i, group := range indicator.Queries {
+		queryGroup := make([]interface{}, len(group.QueryGroup))
+		for j, query := range group.QueryGroup {
+			queryGroup[j] = map[string]interface{}{
+				"row_id":        query.RowID,
+				"query":         query.Query,
+				"use_row_count": query.UseRowCount,
+				"field":         query.Field,
+			}
+		}
+		queries[i] = map[string]interface{}{
+			"query_group_type": group.QueryGroupType,
+			"query_group":      queryGroup,
+		}
+	}
+
+	evaluation := map[string]interface{}{
+		"query_type": indicator.QueryType,
+		"queries":    queries,
+	}
+	if indicator.EvaluationType == "Window" {
+		evaluation["threshold"] = indicator.Threshold
+		evaluation["op"] = indicator.Op
+		evaluation["aggregation"] = indicator.Aggregation
+		evaluation["size"] = indicator.Size
+	}
+	block, ok := sloEvaluationBlocks[indicator.EvaluationType]
+	if !ok {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		block: []interface{}{evaluation},
+	}}
+}
+
+// resourceSumologicSlosLibrarySloCustomizeDiff checks the compliance period
+// against the compliance type, and the query groups against the evaluation
+// type, at plan time.
+func resourceSumologicSlosLibrarySloCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("compliance") {
+		if compliance := firstBlock(d.Get("compliance")); compliance != nil {
+			size, _ := compliance["size"].(string)
+			switch compliance["compliance_type"] {
+			case "Rolling":
+				if !sloRollingPeriodPattern.MatchString(size) {
+					return fmt.Errorf("compliance.0.size must be a number of days between 1d and 90d for a Rolling compliance, got %q", size)
+				}
+			case "Calendar":
+				if !containsString(sloCalendarPeriods, size) {
+					return fmt.Errorf("compliance.0.size must be one of %s for a Calendar compliance, got %q",
+						strings.Join(sloCalendarPeriods, ", "), size)
+				}
+			}
+		}
+	}
+
+	if !d.NewValueKnown("indicator") {
+		return nil
+	}
+	indicator := firstBlock(d.Get("indicator"))
+	for evaluationType, block := range sloEvaluationBlocks {
+		evaluation := firstBlock(indicator[block])
+		if evaluation == nil {
+			continue
+		}
+		if err := validateSloEvaluation(evaluationType, "indicator.0."+block+".0", evaluation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSloEvaluation(evaluationType, path string, evaluation map[string]interface{}) error {
+	groups := map[string]int{}
+	usesRowCount := false
+	for i, raw := range evaluation["queries"].([]interface{}) {
+		queries, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		queryGroupType, _ := queries["query_group_type"].(string)
+		groups[queryGroupType]++
+		for j, rawQuery := range queries["query_group"].([]interface{}) {
+			query, ok := rawQuery.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			queryPath := fmt.Sprintf("%s.queries.%d.query_group.%d", path, i, j)
+			useRowCount, _ := query["use_row_count"].(bool)
+			field, _ := query["field"].(string)
+			usesRowCount = usesRowCount || useRowCount
+			switch {
+			case evaluation["query_type"] == "Metrics" && useRowCount:
+				return fmt.Errorf("%s.use_row_count must be false for Metrics queries", queryPath)
+			case evaluation["query_type"] == "Logs" && !useRowCount && field == "":
+				return fmt.Errorf("%s.field is required unless use_row_count is true", queryPath)
+			case useRowCount && field != "":
+				return fmt.Errorf("%s.field cannot be set when use_row_count is true", queryPath)
+			}
+		}
+	}
+
+	if evaluationType == "Window" {
+		if len(groups) != 1 || groups["Threshold"] != 1 {
+			return fmt.Errorf("%s.queries must contain a single Threshold query group", path)
+		}
+		if aggregation, _ := evaluation["aggregation"].(string); aggregation == "" && !usesRowCount {
+			return fmt.Errorf("%s.aggregation is required unless the query uses use_row_count", path)
+		}
+		return nil
+	}
+	if groups["Total"] != 1 || groups["Successful"]+groups["Unsuccessful"] != 1 || groups["Threshold"] > 0 {
+		return fmt.Errorf("%s.queries must contain a Total query group and either a Successful or an "+
+			"Unsuccessful query group", path)
+	}
+	return nil
+}
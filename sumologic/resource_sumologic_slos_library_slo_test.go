@@ -0,0 +1,213 @@
+package sumologic
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestUnitSumologicSlo(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := func(size, queryGroupType, monitor string) string {
+		return fmt.Sprintf(`
+resource "sumologic_slo_folder" "reliability" {
+	name = "Reliability"
+}
+
+resource "sumologic_slo" "latency" {
+	name = "Login latency"
+	parent_id = sumologic_slo_folder.reliability.id
+	signal_type = "Latency"
+	service = "auth"
+	application = "login"
+	compliance {
+		compliance_type = "Rolling"
+		size = "%s"
+		target = 99
+		timezone = "Europe/Berlin"
+	}
+	indicator {
+		window_based_evaluation {
+			query_type = "Metrics"
+			size = "1m"
+			threshold = 200
+			op = "LessThan"
+			aggregation = "Avg"
+			queries {
+				query_group_type = "%s"
+				query_group {
+					row_id = "A"
+					query = "metric=request_time_p90 service=auth"
+					use_row_count = false
+				}
+			}
+		}
+	}
+}
+
+resource "sumologic_slo" "errors" {
+	name = "Login errors"
+	signal_type = "Error"
+	compliance {
+		compliance_type = "Calendar"
+		size = "Month"
+		target = 99.9
+		timezone = "UTC"
+	}
+	indicator {
+		request_based_evaluation {
+			query_type = "Logs"
+			queries {
+				query_group_type = "Unsuccessful"
+				query_group {
+					row_id = "A"
+					query = "_sourceCategory=auth status>=500"
+					use_row_count = true
+				}
+			}
+			queries {
+				query_group_type = "Total"
+				query_group {
+					row_id = "B"
+					query = "_sourceCategory=auth"
+					use_row_count = true
+				}
+			}
+		}
+	}
+}
+
+resource "sumologic_monitor" "burn_rate" {
+	name = "Login errors burn rate"
+	%s
+	trigger_conditions {
+		slo_burn_rate_condition {
+			critical {
+				time_range = "1h"
+				burn_rate_threshold = 14.4
+			}
+			warning {
+				time_range = "6h"
+				burn_rate_threshold = 6
+			}
+		}
+	}
+}`, size, queryGroupType, monitor)
+	}
+	sloMonitor := `monitor_type = "Slo"
+	slo_id = sumologic_slo.errors.id`
+	var errorsPath string
+	sloPath := func(attributes map[string]string) string {
+		return "v1/slos/" + attributes["id"]
+	}
+	monitorPath := func(attributes map[string]string) string {
+		return "v1/monitors/" + attributes["id"]
+	}
+	burnRateTrigger := func(triggerType, timeRange string, threshold float64) map[string]interface{} {
+		return map[string]interface{}{"triggerType": triggerType, "detectionMethod": "SloBurnRateCondition",
+			"timeRange": timeRange, "burnRateThreshold": threshold}
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config:      config("Month", "Threshold", sloMonitor),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`compliance.0.size must be a number of days between 1d and 90d for a Rolling compliance, got "Month"`),
+		},
+		resource.TestStep{
+			Config:      config("28d", "Total", sloMonitor),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("indicator.0.window_based_evaluation.0.queries must contain a single Threshold query group"),
+		},
+		resource.TestStep{
+			Config:      config("28d", "Threshold", `monitor_type = "Slo"`),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("slo_id is required for monitor type Slo"),
+		},
+		resource.TestStep{
+			Config: config("28d", "Threshold", `monitor_type = "Logs"
+	queries {
+		row_id = "A"
+		query = "_sourceCategory=auth"
+	}`),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("trigger_conditions.0.slo_burn_rate_condition is not supported for monitor type Logs"),
+		},
+		resource.TestStep{
+			Config: config("28d", "Threshold", sloMonitor),
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_slo.latency", sloPath, map[string]interface{}{
+					"type": "SlosLibrarySlo", "signalType": "Latency", "service": "auth",
+					"compliance": map[string]interface{}{
+						"complianceType": "Rolling", "size": "28d", "target": 99, "timezone": "Europe/Berlin",
+					},
+					"indicator": map[string]interface{}{
+						"evaluationType": "Window", "queryType": "Metrics", "size": "1m", "threshold": 200,
+						"op": "LessThan", "aggregation": "Avg",
+						"queries": []interface{}{map[string]interface{}{
+							"queryGroupType": "Threshold",
+							"queryGroup": []interface{}{map[string]interface{}{
+								"rowId": "A", "query": "metric=request_time_p90 service=auth", "useRowCount": false,
+							}},
+						}},
+					},
+				}),
+				resource.TestCheckResourceAttrPair("sumologic_slo.latency", "parent_id", "sumologic_slo_folder.reliability", "id"),
+				api.checkObject("sumologic_slo.errors", func(attributes map[string]string) string {
+					errorsPath = sloPath(attributes)
+					return errorsPath
+				}, map[string]interface{}{
+					"parentId": fakeSlosRootID,
+					"indicator": map[string]interface{}{
+						"evaluationType": "Request", "queryType": "Logs",
+						"queries": []interface{}{
+							map[string]interface{}{"queryGroupType": "Unsuccessful", "queryGroup": []interface{}{map[string]interface{}{
+								"rowId": "A", "query": "_sourceCategory=auth status>=500", "useRowCount": true,
+							}}},
+							map[string]interface{}{"queryGroupType": "Total", "queryGroup": []interface{}{map[string]interface{}{
+								"rowId": "B", "query": "_sourceCategory=auth", "useRowCount": true,
+							}}},
+						},
+					},
+				}),
+				resource.TestCheckResourceAttrPair("sumologic_monitor.burn_rate", "slo_id", "sumologic_slo.errors", "id"),
+				api.checkObject("sumologic_monitor.burn_rate", monitorPath, map[string]interface{}{
+					"monitorType": "Slo",
+					"triggers": []interface{}{
+						burnRateTrigger("Critical", "1h", 14.4),
+						burnRateTrigger("ResolvedCritical", "1h", 14.4),
+						burnRateTrigger("Warning", "6h", 6),
+						burnRateTrigger("ResolvedWarning", "6h", 6),
+					},
+				}),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_slo.latency",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_slo.errors",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_monitor.burn_rate",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update(errorsPath, func(slo map[string]interface{}) {
+					slo["compliance"].(map[string]interface{})["target"] = 99.5
+				})
+			},
+			Config:             config("28d", "Threshold", sloMonitor),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+	)
+}
@@ -0,0 +1,211 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceSumologicSourcePause pauses sources for as long as it exists, e.g.
+// for a maintenance window, and resumes them when destroyed. Sources that
+// were paused already stay paused, and sources resumed outside Terraform are
+// paused again by an update.
+func resourceSumologicSourcePause() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceSumologicSourcePauseCreate,
+		Read:          resourceSumologicSourcePauseRead,
+		Update:        resourceSumologicSourcePauseUpdate,
+		Delete:        resourceSumologicSourcePauseDelete,
+		CustomizeDiff: resourceSumologicSourcePauseCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"collector_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"already_paused_source_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"resumed_source_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func resourceSumologicSourcePauseCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	collectorID := int64(d.Get("collector_id").(int))
+	sourceIDs := sourcePauseIDs(d, "source_ids")
+
+	var alreadyPaused []int
+	var paused []int
+	for _, sourceID := range sourceIDs {
+		found, wasPaused, err := c.SetSourcePaused(collectorID, sourceID, true)
+		if err == nil && !found {
+			err = fmt.Errorf("source with id %d does not exist on collector %d", sourceID, collectorID)
+		}
+		if err != nil {
+			// Resume the sources paused so far, so that a failed apply
+			// leaves nothing behind.
+			for _, id := range paused {
+				if _, _, resumeErr := c.SetSourcePaused(collectorID, id, false); resumeErr != nil {
+					log.Printf("[WARN] Failed to resume source %d of collector %d: %s", id, collectorID, resumeErr)
+				}
+			}
+			return err
+		}
+		if wasPaused {
+			alreadyPaused = append(alreadyPaused, sourceID)
+		} else {
+			paused = append(paused, sourceID)
+		}
+	}
+
+	ids := make([]string, len(sourceIDs))
+	for i, sourceID := range sourceIDs {
+		ids[i] = strconv.Itoa(sourceID)
+	}
+	d.SetId(fmt.Sprintf("%d/%s", collectorID, strings.Join(ids, ",")))
+	d.Set("already_paused_source_ids", alreadyPaused)
+
+	log.Printf("[INFO] Paused %d sources of collector %d: %s", len(paused), collectorID, d.Get("reason").(string))
+	return resourceSumologicSourcePauseRead(d, meta)
+}
+
+// resourceSumologicSourcePauseRead reports the sources that were resumed
+// outside Terraform in resumed_source_ids. Sources that were deleted are
+// skipped.
+func resourceSumologicSourcePauseRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	collectorID := int64(d.Get("collector_id").(int))
+	resumed := []int{}
+	found := 0
+	for _, sourceID := range sourcePauseIDs(d, "source_ids") {
+		source, definition, err := c.GetSourceDefinition(collectorID, sourceID)
+		if err != nil {
+			return err
+		}
+		if source == nil {
+			log.Printf("[WARN] Paused source %d of collector %d no longer exists", sourceID, collectorID)
+			continue
+		}
+		found++
+		var settings struct {
+			Paused bool `json:"paused"`
+		}
+		if err := json.Unmarshal(definition, &settings); err != nil {
+			return err
+		}
+		if !settings.Paused {
+			resumed = append(resumed, sourceID)
+		}
+	}
+
+	if found == 0 {
+		log.Printf("[WARN] None of the sources exist anymore, removing the pause %s from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return d.Set("resumed_source_ids", resumed)
+}
+
+// resourceSumologicSourcePauseUpdate pauses the sources that were resumed
+// outside Terraform again.
+func resourceSumologicSourcePauseUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	collectorID := int64(d.Get("collector_id").(int))
+	for _, sourceID := range sourcePauseIDs(d, "source_ids") {
+		found, wasPaused, err := c.SetSourcePaused(collectorID, sourceID, true)
+		if err != nil {
+			return err
+		}
+		if !found {
+			log.Printf("[WARN] Paused source %d of collector %d no longer exists", sourceID, collectorID)
+		} else if !wasPaused {
+			log.Printf("[INFO] Paused source %d of collector %d again: %s", sourceID, collectorID, d.Get("reason").(string))
+		}
+	}
+
+	return resourceSumologicSourcePauseRead(d, meta)
+}
+
+// resourceSumologicSourcePauseCustomizeDiff plans an update when sources were
+// resumed outside Terraform, rather than replacing the pause.
+func resourceSumologicSourcePauseCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if resumed, ok := d.Get("resumed_source_ids").(*schema.Set); ok && resumed.Len() > 0 {
+		return d.SetNewComputed("resumed_source_ids")
+	}
+	return nil
+}
+
+func resourceSumologicSourcePauseDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	collectorID := int64(d.Get("collector_id").(int))
+	alreadyPaused := map[int]bool{}
+	for _, sourceID := range sourcePauseIDs(d, "already_paused_source_ids") {
+		alreadyPaused[sourceID] = true
+	}
+
+	for _, sourceID := range sourcePauseIDs(d, "source_ids") {
+		if alreadyPaused[sourceID] {
+			continue
+		}
+		found, _, err := c.SetSourcePaused(collectorID, sourceID, false)
+		if err != nil {
+			return err
+		}
+		if !found {
+			log.Printf("[WARN] Paused source %d of collector %d no longer exists", sourceID, collectorID)
+		}
+	}
+
+	return nil
+}
+
+// sourcePauseIDs returns the source IDs of a set attribute in ascending
+// order.
+func sourcePauseIDs(d *schema.ResourceData, key string) []int {
+	raw := d.Get(key).(*schema.Set).List()
+	ids := make([]int, len(raw))
+	for i, id := range raw {
+		ids[i] = id.(int)
+	}
+	sort.Ints(ids)
+	return ids
+}
@@ -0,0 +1,105 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestUnitSumologicSourcePause(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	api.seed("v1/collectors/42", map[string]interface{}{
+		"id": 42, "name": "collector", "collectorType": "Hosted",
+	})
+	api.seed("v1/collectors/43", map[string]interface{}{
+		"id": 43, "name": "managed", "collectorType": "Hosted",
+	})
+	for id, paused := range map[int]bool{1: false, 2: true, 3: false} {
+		api.seed(fmt.Sprintf("v1/collectors/42/sources/%d", id), map[string]interface{}{
+			"id": id, "name": fmt.Sprintf("source-%d", id), "sourceType": "HTTP", "paused": paused,
+		})
+	}
+	config := func(pause string) string {
+		return `
+data "sumologic_collector" "test" {
+	id = 42
+}
+` + pause
+	}
+	pause := `
+resource "sumologic_source_pause" "test" {
+	collector_id = data.sumologic_collector.test.id
+	source_ids = [1, 2]
+	reason = "maintenance"
+}`
+	// The managed source does not set paused, so it has no diff while paused.
+	managed := `
+resource "sumologic_http_source" "managed" {
+	name = "managed"
+	category = "app"
+	collector_id = 43
+}
+
+resource "sumologic_source_pause" "managed" {
+	collector_id = 43
+	source_ids = [sumologic_http_source.managed.id]
+}`
+	checkPaused := func(expected map[int]bool) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			api.mu.Lock()
+			defer api.mu.Unlock()
+			for id, paused := range expected {
+				if source := api.objects[fmt.Sprintf("v1/collectors/42/sources/%d", id)]; source["paused"] != paused {
+					return fmt.Errorf("expected paused of source %d to be %v, got %v", id, paused, source["paused"])
+				}
+			}
+			return nil
+		}
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: config(pause),
+			Check: resource.ComposeTestCheckFunc(
+				checkPaused(map[int]bool{1: true, 2: true, 3: false}),
+				resource.TestCheckResourceAttr("sumologic_source_pause.test", "id", "42/1,2"),
+				resource.TestCheckResourceAttr("sumologic_source_pause.test", "source_ids.#", "2"),
+				resource.TestCheckResourceAttr("sumologic_source_pause.test", "already_paused_source_ids.#", "1"),
+			),
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				for _, id := range []int{1, 2} {
+					api.update(fmt.Sprintf("v1/collectors/42/sources/%d", id), func(source map[string]interface{}) {
+						source["paused"] = false
+					})
+				}
+			},
+			Config:             config(pause),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+		resource.TestStep{
+			// The sources are paused again in place, so source 2 is still
+			// known to have been paused before.
+			Config: config(pause),
+			Check: resource.ComposeTestCheckFunc(
+				checkPaused(map[int]bool{1: true, 2: true, 3: false}),
+				resource.TestCheckResourceAttr("sumologic_source_pause.test", "resumed_source_ids.#", "0"),
+				resource.TestCheckResourceAttr("sumologic_source_pause.test", "already_paused_source_ids.#", "1"),
+			),
+		},
+		resource.TestStep{
+			Config: config(pause + managed),
+			Check: api.checkObject("sumologic_http_source.managed", func(attributes map[string]string) string {
+				return fmt.Sprintf("v1/collectors/43/sources/%s", attributes["id"])
+			}, map[string]interface{}{"paused": true}),
+		},
+		resource.TestStep{
+			Config: config(""),
+			Check:  checkPaused(map[int]bool{1: false, 2: true, 3: false}),
+		},
+	)
+}
@@ -13,7 +13,6 @@ package sumologic
 
 import (
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -67,7 +66,7 @@ func resourceSumologicSubdomainCreate(d *schema.ResourceData, meta interface{})
 		id, err := c.CreateSubdomain(subdomain)
 
 		if err != nil {
-			if strings.Contains(err.Error(), "subdomain:already_configured") {
+			if hasErrorCode(err, "subdomain:already_configured") {
 				updatedID, updateErr := c.UpdateSubdomain(subdomain)
 				if updateErr != nil {
 					if updatedID == "" {
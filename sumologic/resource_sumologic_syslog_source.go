@@ -0,0 +1,105 @@
+package sumologic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSumologicSyslogSource() *schema.Resource {
+	syslogSource := resourceSumologicSource()
+	syslogSource.Create = resourceSumologicSyslogSourceCreate
+	syslogSource.Read = resourceSumologicSyslogSourceRead
+	syslogSource.Update = resourceSumologicSyslogSourceUpdate
+	syslogSource.Importer = &schema.ResourceImporter{
+		State: resourceSumologicSourceImport,
+	}
+
+	syslogSource.Schema["protocol"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "UDP",
+		ValidateFunc: validation.StringInSlice([]string{"UDP", "TCP"}, false),
+	}
+	syslogSource.Schema["port"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      514,
+		ValidateFunc: validation.IntBetween(1, 65535),
+	}
+
+	return syslogSource
+}
+
+func resourceSumologicSyslogSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	if d.Id() == "" {
+		source := resourceToSyslogSource(d)
+
+		id, err := c.CreateSyslogSource(source, d.Get("collector_id").(int))
+
+		if err != nil {
+			return err
+		}
+
+		d.SetId(strconv.Itoa(id))
+	}
+
+	return resourceSumologicSyslogSourceRead(d, meta)
+}
+
+func resourceSumologicSyslogSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	source := resourceToSyslogSource(d)
+
+	err := c.UpdateSyslogSource(source, d.Get("collector_id").(int))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceSumologicSyslogSourceRead(d, meta)
+}
+
+func resourceToSyslogSource(d *schema.ResourceData) SyslogSource {
+	source := resourceToSource(d)
+	source.Type = "Syslog"
+
+	syslogSource := SyslogSource{
+		Source:   source,
+		Protocol: d.Get("protocol").(string),
+		Port:     d.Get("port").(int),
+	}
+
+	return syslogSource
+}
+
+func resourceSumologicSyslogSourceRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	id, _ := strconv.Atoi(d.Id())
+	source, err := c.GetSyslogSource(d.Get("collector_id").(int), id)
+	if err != nil {
+		return err
+	}
+
+	if source == nil {
+		log.Printf("[WARN] Syslog source not found, removing from state: %v - %v", id, err)
+		d.SetId("")
+
+		return nil
+	}
+
+	if err := resourceSumologicSourceRead(d, source.Source); err != nil {
+		return fmt.Errorf("%s", err)
+	}
+	d.Set("protocol", source.Protocol)
+	d.Set("port", source.Port)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package sumologic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestUnitSumologicSyslogSource(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	api.seed("v1/collectors/42", map[string]interface{}{
+		"id": 42, "name": "host-collector", "collectorType": "Installable",
+	})
+	config := `
+resource "sumologic_syslog_source" "test" {
+	collector_id = 42
+	name = "syslog"
+	protocol = "TCP"
+	port = 1514
+}`
+	sourcePath := func(attributes map[string]string) string {
+		return fmt.Sprintf("v1/collectors/%s/sources/%s", attributes["collector_id"], attributes["id"])
+	}
+	var syslogPath string
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: config,
+			Check: resource.ComposeTestCheckFunc(
+				api.checkObject("sumologic_syslog_source.test", sourcePath, map[string]interface{}{
+					"sourceType": "Syslog", "protocol": "TCP", "port": float64(1514),
+				}),
+				func(s *terraform.State) error {
+					syslogPath = sourcePath(s.RootModule().Resources["sumologic_syslog_source.test"].Primary.Attributes)
+					return nil
+				},
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "sumologic_syslog_source.test",
+			ImportState:       true,
+			ImportStateIdFunc: testImportStateIdFunc("sumologic_syslog_source.test", "collector_id", "id"),
+			ImportStateVerify: true,
+		},
+		resource.TestStep{
+			PreConfig: func() {
+				api.update(syslogPath, func(source map[string]interface{}) {
+					source["port"] = 514
+				})
+			},
+			Config:             config,
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+		resource.TestStep{
+			Config: config,
+		},
+	)
+}
@@ -231,3 +231,31 @@ func testAccCheckUserAttributes(name string) resource.TestCheckFunc {
 		return f(s)
 	}
 }
+
+func TestUnitSumologicUser(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	userPath := func(attributes map[string]string) string {
+		return "v1/users/" + attributes["id"]
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config: testAccSumologicUser("Jane", "Doe", "jane@example.com", true, ""),
+			Check: api.checkObject("sumologic_user.test", userPath, map[string]interface{}{
+				"firstName": "Jane", "email": "jane@example.com", "isActive": true,
+			}),
+		},
+		resource.TestStep{
+			Config: testAccSumologicUserUpdate("Jane", "Roe", "jane@example.com", false, ""),
+			Check: api.checkObject("sumologic_user.test", userPath, map[string]interface{}{
+				"lastName": "Roe", "isActive": false,
+			}),
+		},
+		resource.TestStep{
+			ResourceName:            "sumologic_user.test",
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"transfer_to"},
+		},
+	)
+}
@@ -0,0 +1,110 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type AzureSource struct {
+	Source
+	ThirdPartyRef AzureThirdPartyRef `json:"thirdPartyRef,omitempty"`
+}
+
+type AzureThirdPartyRef struct {
+	Resources []AzureResource `json:"resources"`
+}
+
+type AzureResource struct {
+	ServiceType    string              `json:"serviceType"`
+	Authentication AzureAuthentication `json:"authentication"`
+	Path           AzurePath           `json:"path"`
+}
+
+type AzureAuthentication struct {
+	Type                   string `json:"type"`
+	SharedAccessPolicyName string `json:"sharedAccessPolicyName,omitempty"`
+	SharedAccessPolicyKey  string `json:"sharedAccessPolicyKey,omitempty"`
+	SasKeyName             string `json:"sasKeyName,omitempty"`
+	SasKey                 string `json:"sasKey,omitempty"`
+}
+
+type AzurePath struct {
+	Type               string `json:"type"`
+	Namespace          string `json:"namespace"`
+	EventHubName       string `json:"eventHubName"`
+	ConsumerGroup      string `json:"consumerGroup,omitempty"`
+	Region             string `json:"region,omitempty"`
+	StorageAccountName string `json:"storageAccountName,omitempty"`
+	ContainerName      string `json:"containerName,omitempty"`
+	PathExpression     string `json:"pathExpression,omitempty"`
+}
+
+func (s *Client) CreateAzureSource(azureSource AzureSource, collectorID int) (int, error) {
+
+	type AzureSourceMessage struct {
+		Source AzureSource `json:"source"`
+	}
+
+	request := AzureSourceMessage{
+		Source: azureSource,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources", collectorID)
+	body, err := s.Post(urlPath, request, false)
+
+	if err != nil {
+		return -1, err
+	}
+
+	var response AzureSourceMessage
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return -1, err
+	}
+
+	return response.Source.ID, nil
+}
+
+func (s *Client) GetAzureSource(collectorID, sourceID int) (*AzureSource, error) {
+
+	body, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if body == nil {
+		return nil, nil
+	}
+
+	type Response struct {
+		Source AzureSource `json:"source"`
+	}
+
+	var response Response
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Source.ETag = etag
+
+	return &response.Source, nil
+}
+
+func (s *Client) UpdateAzureSource(source AzureSource, collectorID int) error {
+
+	type AzureSourceMessage struct {
+		Source AzureSource `json:"source"`
+	}
+
+	request := AzureSourceMessage{
+		Source: source,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, source.ID)
+	_, err := s.PutWithETag(urlPath, request, source.ETag, false)
+
+	return err
+}
@@ -2,11 +2,12 @@ package sumologic
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"net/url"
 	"time"
@@ -16,12 +17,22 @@ type HttpClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+// Client talks to the Sumo Logic API. Requests are bound to the client's
+// context, so every method can be made cancellable with WithContext, e.g.
+// c.WithContext(ctx).GetCollector(id).
 type Client struct {
 	AccessID    string
 	AccessKey   string
 	Environment string
 	BaseURL     *url.URL
 	httpClient  HttpClient
+	// MaxRetries is the number of times a failed request is retried before
+	// the error is returned to the caller.
+	MaxRetries int
+	// RetryMaxWait caps the backoff between two retry attempts.
+	RetryMaxWait time.Duration
+	limiter      *rateLimiter
+	ctx          context.Context
 }
 
 var ProviderVersion string
@@ -38,10 +49,13 @@ var endpoints = map[string]string{
 	"in":  "https://api.in.sumologic.com/api/",
 }
 
-var rateLimiter = time.NewTicker(time.Minute / 240)
+const (
+	defaultMaxRetries   = 5
+	defaultRetryMaxWait = 30 * time.Second
+)
 
-func createNewRequest(method, url string, body io.Reader, accessID string, accessKey string) (*http.Request, error) {
-	req, err := http.NewRequest(method, url, body)
+func createNewRequest(ctx context.Context, method, url string, body io.Reader, accessID string, accessKey string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
@@ -51,244 +65,271 @@ func createNewRequest(method, url string, body io.Reader, accessID string, acces
 	return req, nil
 }
 
-func (s *Client) PostWithCookies(urlPath string, payload interface{}) ([]byte, []*http.Cookie, error) {
-	relativeURL, err := url.Parse(urlPath)
-	if err != nil {
-		return nil, nil, err
-	}
+// apiRequest describes a single call to the Sumo Logic API. The body is kept
+// as a byte slice so that the request can be rebuilt for every retry attempt.
+type apiRequest struct {
+	method      string
+	urlPath     string
+	body        []byte
+	isAdminMode bool
+	headers     map[string]string
+	cookies     []*http.Cookie
+}
 
-	sumoURL := s.BaseURL.ResolveReference(relativeURL)
+// WithContext returns a shallow copy of the client whose requests are bound to
+// ctx. The copy shares the HTTP client and the rate limiter with s.
+func (s *Client) WithContext(ctx context.Context) *Client {
+	client := *s
+	client.ctx = ctx
+	return &client
+}
 
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return nil, nil, err
+// Context returns the context requests made by the client are bound to.
+func (s *Client) Context() context.Context {
+	if s.ctx == nil {
+		return context.Background()
 	}
+	return s.ctx
+}
 
-	req, err := createNewRequest(http.MethodPost, sumoURL.String(), bytes.NewBuffer(body), s.AccessID, s.AccessKey)
-	if err != nil {
-		return nil, nil, err
-	}
+// withTimeout binds the client to a context that expires after timeout, so
+// that resource timeouts apply to every call made on their behalf.
+func (s *Client) withTimeout(timeout time.Duration) (*Client, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(s.Context(), timeout)
+	return s.WithContext(ctx), cancel
+}
 
-	<-rateLimiter.C
-	resp, err := s.httpClient.Do(req)
+func (s *Client) resolveURL(urlPath string) (string, error) {
+	relativeURL, err := url.Parse(urlPath)
 	if err != nil {
-		return nil, nil, err
+		return "", err
 	}
-	defer resp.Body.Close()
-
-	respCookie := resp.Cookies()
+	return s.BaseURL.ResolveReference(relativeURL).String(), nil
+}
 
-	d, err := ioutil.ReadAll(resp.Body)
+// send executes the request, retrying transient failures with jittered
+// exponential backoff. The response body is fully read and closed before
+// returning. Responses with a status code of 400 or above are returned along
+// with an *APIError.
+func (s *Client) send(r apiRequest) (*http.Response, []byte, error) {
+	ctx := s.Context()
+	sumoURL, err := s.resolveURL(r.urlPath)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if resp.StatusCode >= 400 {
-		return nil, nil, errors.New(string(d))
-	}
+	for attempt := 0; ; attempt++ {
+		var body io.Reader
+		if r.body != nil {
+			body = bytes.NewReader(r.body)
+		}
+		req, err := createNewRequest(ctx, r.method, sumoURL, body, s.AccessID, s.AccessKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		if r.isAdminMode {
+			req.Header.Add("isAdminMode", "true")
+		}
+		for name, value := range r.headers {
+			req.Header.Add(name, value)
+		}
+		for _, cookie := range r.cookies {
+			req.AddCookie(cookie)
+		}
 
-	return d, respCookie, nil
-}
+		if err := s.limiter.Wait(ctx); err != nil {
+			return nil, nil, err
+		}
+		resp, err := s.httpClient.Do(req)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			if err == nil {
+				resp.Body.Close()
+			}
+			return nil, nil, ctxErr
+		}
 
-func (s *Client) GetWithCookies(urlPath string, cookies []*http.Cookie) ([]byte, string, error) {
-	relativeURL, err := url.Parse(urlPath)
-	if err != nil {
-		return nil, "", err
-	}
+		var d []byte
+		if err == nil {
+			d, err = ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusTooManyRequests {
+				s.limiter.Throttled()
+			} else {
+				s.limiter.Succeeded()
+			}
+		}
 
-	sumoURL := s.BaseURL.ResolveReference(relativeURL)
+		if attempt >= s.MaxRetries || !shouldRetry(r.method, resp, err) {
+			if err != nil {
+				return nil, nil, err
+			}
+			if resp.StatusCode >= 400 {
+				return resp, d, newAPIError(r.method, sumoURL, resp, d)
+			}
+			return resp, d, nil
+		}
 
-	req, err := createNewRequest(http.MethodGet, sumoURL.String(), nil, s.AccessID, s.AccessKey)
-	if err != nil {
-		return nil, "", err
+		wait := s.retryWait(attempt, resp)
+		if err != nil {
+			log.Printf("[WARN] %s %s failed: %s; retrying in %s (attempt %d of %d)",
+				r.method, sumoURL, err, wait, attempt+1, s.MaxRetries)
+		} else {
+			log.Printf("[WARN] %s %s returned %d; retrying in %s (attempt %d of %d)",
+				r.method, sumoURL, resp.StatusCode, wait, attempt+1, s.MaxRetries)
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, nil, err
+		}
 	}
+}
 
-	for _, cookie := range cookies {
-		req.AddCookie(cookie)
+// sleepContext waits for d or until ctx is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
+}
 
-	<-rateLimiter.C
-	resp, err := s.httpClient.Do(req)
+func (s *Client) PostWithCookies(urlPath string, payload interface{}) ([]byte, []*http.Cookie, error) {
+	body, err := json.Marshal(payload)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
-	defer resp.Body.Close()
 
-	d, err := ioutil.ReadAll(resp.Body)
+	resp, d, err := s.send(apiRequest{
+		method:  http.MethodPost,
+		urlPath: urlPath,
+		body:    body,
+	})
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
-	if resp.StatusCode == 404 {
+	return d, resp.Cookies(), nil
+}
+
+func (s *Client) GetWithCookies(urlPath string, cookies []*http.Cookie) ([]byte, string, error) {
+	resp, d, err := s.send(apiRequest{
+		method:  http.MethodGet,
+		urlPath: urlPath,
+		cookies: cookies,
+	})
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
 		return nil, "", nil
-	} else if resp.StatusCode >= 400 {
-		return nil, "", errors.New(string(d))
+	} else if err != nil {
+		return nil, "", err
 	}
 
 	return d, resp.Header.Get("ETag"), nil
 }
 
 func (s *Client) Post(urlPath string, payload interface{}, isAdminMode bool) ([]byte, error) {
-	relativeURL, _ := url.Parse(urlPath)
-	sumoURL := s.BaseURL.ResolveReference(relativeURL)
-
-	body, _ := json.Marshal(payload)
-	req, err := createNewRequest(http.MethodPost, sumoURL.String(), bytes.NewBuffer(body), s.AccessID, s.AccessKey)
-	if err != nil {
-		return nil, err
-	}
-
-	if isAdminMode {
-		req.Header.Add("isAdminMode", "true")
-	}
-
-	<-rateLimiter.C
-	resp, err := s.httpClient.Do(req)
+	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	d, err := ioutil.ReadAll(resp.Body)
+	_, d, err := s.send(apiRequest{
+		method:      http.MethodPost,
+		urlPath:     urlPath,
+		body:        body,
+		isAdminMode: isAdminMode,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if resp.StatusCode >= 400 {
-		return nil, errors.New(string(d))
-	}
-
 	return d, nil
 }
 
 func (s *Client) PostRawPayload(urlPath string, payload string) ([]byte, error) {
-	relativeURL, _ := url.Parse(urlPath)
-	sumoURL := s.BaseURL.ResolveReference(relativeURL)
-	req, err := createNewRequest(http.MethodPost, sumoURL.String(), bytes.NewBuffer([]byte(payload)), s.AccessID, s.AccessKey)
+	_, d, err := s.send(apiRequest{
+		method:  http.MethodPost,
+		urlPath: urlPath,
+		body:    []byte(payload),
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	<-rateLimiter.C
-	resp, err := s.httpClient.Do(req)
-
-	if err != nil {
-		return nil, err
-	}
-
-	d, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode >= 400 {
-		return nil, errors.New(string(d))
-	}
-
 	return d, nil
 }
 
 func (s *Client) Put(urlPath string, payload interface{}, isAdminMode bool) ([]byte, error) {
-	relativeURL, _ := url.Parse(urlPath)
-	sumoURL := s.BaseURL.ResolveReference(relativeURL)
-
-	_, etag, _ := s.Get(sumoURL.String(), false)
+	return s.PutWithETag(urlPath, payload, "", isAdminMode)
+}
 
-	body, _ := json.Marshal(payload)
-	req, err := createNewRequest(http.MethodPut, sumoURL.String(), bytes.NewBuffer(body), s.AccessID, s.AccessKey)
+// PutWithETag sends an If-Match header with etag, if set, so that the API
+// rejects the update when the object was modified since etag was read.
+func (s *Client) PutWithETag(urlPath string, payload interface{}, etag string, isAdminMode bool) ([]byte, error) {
+	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Add("If-Match", etag)
 
-	if isAdminMode {
-		req.Header.Add("isAdminMode", "true")
+	var headers map[string]string
+	if etag != "" {
+		headers = map[string]string{"If-Match": etag}
 	}
 
-	<-rateLimiter.C
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
+	_, d, err := s.send(apiRequest{
+		method:      http.MethodPut,
+		urlPath:     urlPath,
+		body:        body,
+		isAdminMode: isAdminMode,
+		headers:     headers,
+	})
+	if IsPreconditionFailed(err) {
+		return nil, fmt.Errorf("resource changed outside Terraform since last refresh, "+
+			"refresh the state and review the plan before applying again: %w", err)
+	} else if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	d, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode >= 400 {
-		return nil, errors.New(string(d))
-	}
 
 	return d, nil
 }
 
 func (s *Client) Get(urlPath string, isAdminMode bool) ([]byte, string, error) {
-	relativeURL, _ := url.Parse(urlPath)
-	sumoURL := s.BaseURL.ResolveReference(relativeURL)
-
-	req, err := createNewRequest(http.MethodGet, sumoURL.String(), nil, s.AccessID, s.AccessKey)
-	if err != nil {
-		return nil, "", err
-	}
-
-	if isAdminMode {
-		req.Header.Add("isAdminMode", "true")
-	}
-
-	<-rateLimiter.C
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		return nil, "", err
-	}
-	defer resp.Body.Close()
-
-	d, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, "", err
-	}
-
-	if resp.StatusCode == 404 {
+	resp, d, err := s.send(apiRequest{
+		method:      http.MethodGet,
+		urlPath:     urlPath,
+		isAdminMode: isAdminMode,
+	})
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
 		return nil, "", nil
-	} else if resp.StatusCode >= 400 {
-		return nil, "", errors.New(string(d))
+	} else if err != nil {
+		return nil, "", err
 	}
 
 	return d, resp.Header.Get("ETag"), nil
 }
 
 func (s *Client) Delete(urlPath string) ([]byte, error) {
-	relativeURL, _ := url.Parse(urlPath)
-	sumoURL := s.BaseURL.ResolveReference(relativeURL)
-
-	req, err := createNewRequest(http.MethodDelete, sumoURL.String(), nil, s.AccessID, s.AccessKey)
-	if err != nil {
-		return nil, err
-	}
-
-	<-rateLimiter.C
-	resp, err := s.httpClient.Do(req)
+	_, d, err := s.send(apiRequest{
+		method:  http.MethodDelete,
+		urlPath: urlPath,
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	d, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode >= 400 {
-		return nil, errors.New(string(d))
-	}
 
 	return d, nil
 }
 
 func NewClient(accessID, accessKey, environment, base_url string) (*Client, error) {
 	client := Client{
-		AccessID:    accessID,
-		AccessKey:   accessKey,
-		httpClient:  http.DefaultClient,
-		Environment: environment,
+		AccessID:     accessID,
+		AccessKey:    accessKey,
+		httpClient:   http.DefaultClient,
+		Environment:  environment,
+		MaxRetries:   defaultMaxRetries,
+		RetryMaxWait: defaultRetryMaxWait,
+		limiter:      newRateLimiter(defaultRequestsPerMinute, defaultBurst),
 	}
 
 	if base_url == "" {
@@ -0,0 +1,162 @@
+package sumologic
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// blockingHttpClient never answers on its own and only returns once the
+// request's context is done.
+type blockingHttpClient struct {
+	calls int
+}
+
+func (c *blockingHttpClient) Do(req *http.Request) (*http.Response, error) {
+	c.calls++
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func TestClientRequestsAreBoundToContext(t *testing.T) {
+	httpClient := &blockingHttpClient{}
+	client := newRetryTestClient(httpClient)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := client.WithContext(ctx).Get("v1/collectors", false)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the request to be abandoned at the deadline, took %s", elapsed)
+	}
+	if httpClient.calls != 1 {
+		t.Errorf("Expected a cancelled request not to be retried, got %d calls", httpClient.calls)
+	}
+}
+
+// cancellingHttpClient cancels the request's context while answering, like a
+// response that arrives as Terraform is interrupted.
+type cancellingHttpClient struct {
+	cancel context.CancelFunc
+	body   *closeTrackingBody
+}
+
+func (c *cancellingHttpClient) Do(req *http.Request) (*http.Response, error) {
+	c.cancel()
+	return &http.Response{StatusCode: 200, Body: c.body, Header: http.Header{}}, nil
+}
+
+type closeTrackingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *closeTrackingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestCancelledContextClosesResponseBody(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	body := &closeTrackingBody{Reader: strings.NewReader("{}")}
+	client := newRetryTestClient(&cancellingHttpClient{cancel: cancel, body: body})
+
+	_, _, err := client.WithContext(ctx).Get("v1/collectors", false)
+	if err != context.Canceled {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if !body.closed {
+		t.Error("Expected the response body to be closed")
+	}
+}
+
+func TestCancelledContextInterruptsRetryBackoff(t *testing.T) {
+	httpClient := &sequenceHttpClient{
+		responses: []*http.Response{
+			newTestResponse(503, "", http.Header{"Retry-After": []string{"60"}}),
+			newTestResponse(200, "", nil),
+		},
+	}
+	client := newRetryTestClient(httpClient)
+	client.RetryMaxWait = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.WithContext(ctx).Delete("v1/collectors/1")
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the backoff to be interrupted, took %s", elapsed)
+	}
+	if len(httpClient.requests) != 1 {
+		t.Errorf("Expected no retry after cancellation, got %d requests", len(httpClient.requests))
+	}
+}
+
+func TestWithContextDoesNotModifyClient(t *testing.T) {
+	client := newTestClient(nil)
+	client.limiter = newRateLimiter(240, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bound := client.WithContext(ctx)
+
+	if client.Context() != context.Background() {
+		t.Error("Expected the original client to keep its context")
+	}
+	if bound.Context() != ctx {
+		t.Error("Expected the copy to be bound to the new context")
+	}
+	if bound.limiter != client.limiter {
+		t.Error("Expected the copy to share the rate limiter")
+	}
+}
+
+func TestPutWithoutETagMakesASingleRequest(t *testing.T) {
+	httpClient := &sequenceHttpClient{
+		responses: []*http.Response{newTestResponse(200, "{}", nil)},
+	}
+	client := newRetryTestClient(httpClient)
+
+	if _, err := client.Put("v1/roles/1", map[string]string{}, false); err != nil {
+		t.Fatalf("Expected Put to succeed, received: %s", err)
+	}
+	if len(httpClient.requests) != 1 {
+		t.Fatalf("Expected a single request, got %d", len(httpClient.requests))
+	}
+	if _, ok := httpClient.requests[0].Header["If-Match"]; ok {
+		t.Error("Expected no If-Match header without an ETag")
+	}
+}
+
+func TestPutWithETagDetectsConcurrentChanges(t *testing.T) {
+	httpClient := &sequenceHttpClient{
+		responses: []*http.Response{newTestResponse(412, `{"id":"ABC","errors":[{"code":"etag:mismatch","message":"ETag does not match"}]}`, nil)},
+	}
+	client := newRetryTestClient(httpClient)
+
+	_, err := client.PutWithETag("v1/collectors/1", map[string]string{}, `"0a1b2c"`, false)
+	if err == nil {
+		t.Fatal("Expected PutWithETag to fail on 412")
+	}
+	if got := httpClient.requests[0].Header.Get("If-Match"); got != `"0a1b2c"` {
+		t.Errorf("Expected If-Match to carry the ETag, got %q", got)
+	}
+	if !IsPreconditionFailed(err) {
+		t.Errorf("Expected the APIError to be wrapped, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "changed outside Terraform") {
+		t.Errorf("Expected a readable error, got %q", err.Error())
+	}
+}
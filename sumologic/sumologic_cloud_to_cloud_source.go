@@ -10,6 +10,7 @@ type CloudToCloudSource struct {
 	Type      string          `json:"sourceType"`
 	Config    json.RawMessage `json:"config"`
 	SchemaRef SchemaReference `json:"schemaRef"`
+	ETag      string          `json:"-"`
 }
 
 type SchemaReference struct {
@@ -46,7 +47,7 @@ func (s *Client) CreateCloudToCloudSource(source CloudToCloudSource, collectorID
 
 func (s *Client) GetCloudToCloudSource(collectorID, sourceID int) (*CloudToCloudSource, error) {
 	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID)
-	body, _, err := s.Get(urlPath, false)
+	body, etag, err := s.Get(urlPath, false)
 
 	if err != nil {
 		return nil, err
@@ -67,6 +68,8 @@ func (s *Client) GetCloudToCloudSource(collectorID, sourceID int) (*CloudToCloud
 		return nil, err
 	}
 
+	response.Source.ETag = etag
+
 	return &response.Source, nil
 }
 
@@ -81,7 +84,7 @@ func (s *Client) UpdateCloudToCloudSource(source CloudToCloudSource, collectorID
 		Source: source,
 	}
 
-	_, err := s.Put(url, request, false)
+	_, err := s.PutWithETag(url, request, source.ETag, false)
 
 	return err
 }
@@ -39,7 +39,7 @@ func (s *Client) CreateCloudsyslogSource(cloudSyslogSource CloudSyslogSource, co
 
 func (s *Client) GetCloudSyslogSource(collectorID, sourceID int) (*CloudSyslogSource, error) {
 
-	body, _, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
+	body, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +59,8 @@ func (s *Client) GetCloudSyslogSource(collectorID, sourceID int) (*CloudSyslogSo
 		return nil, err
 	}
 
+	response.Source.ETag = etag
+
 	return &response.Source, nil
 }
 
@@ -73,7 +75,7 @@ func (s *Client) UpdateCloudSyslogSource(source CloudSyslogSource, collectorID i
 	}
 
 	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, source.ID)
-	_, err := s.Put(urlPath, request, false)
+	_, err := s.PutWithETag(urlPath, request, source.ETag, false)
 
 	return err
 }
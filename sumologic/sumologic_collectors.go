@@ -3,10 +3,12 @@ package sumologic
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
 )
 
 func (s *Client) GetCollector(id int) (*Collector, error) {
-	data, _, err := s.Get(fmt.Sprintf("v1/collectors/%d", id), false)
+	data, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d", id), false)
 	if err != nil {
 		return nil, err
 	}
@@ -20,12 +22,13 @@ func (s *Client) GetCollector(id int) (*Collector, error) {
 	if err != nil {
 		return nil, err
 	}
+	response.Collector.ETag = etag
 
 	return &response.Collector, nil
 }
 
 func (s *Client) GetCollectorName(name string) (*Collector, error) {
-	data, _, err := s.Get(fmt.Sprintf("v1/collectors/name/%s", name), false)
+	data, etag, err := s.Get(fmt.Sprintf("v1/collectors/name/%s", name), false)
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +42,7 @@ func (s *Client) GetCollectorName(name string) (*Collector, error) {
 	if err != nil {
 		return nil, err
 	}
+	response.Collector.ETag = etag
 
 	return &response.Collector, nil
 }
@@ -78,11 +82,89 @@ func (s *Client) UpdateCollector(collector Collector) error {
 		Collector: collector,
 	}
 
-	_, err := s.Put(url, request, false)
+	_, err := s.PutWithETag(url, request, collector.ETag, false)
 
 	return err
 }
 
+// UpgradeCollector starts upgrading an installed collector to the given version
+// and returns the ID of the upgrade task.
+func (s *Client) UpgradeCollector(id int64, toVersion string) (string, error) {
+	request := CollectorUpgradeRequest{
+		CollectorID: id,
+		ToVersion:   toVersion,
+	}
+
+	responseBody, err := s.Post("v1/collectors/upgrades", request, false)
+	if err != nil {
+		return "", err
+	}
+
+	var response CollectorUpgradeTaskResponse
+	err = json.Unmarshal(responseBody, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.ID, nil
+}
+
+func (s *Client) GetCollectorUpgrade(upgradeID string) (*CollectorUpgrade, error) {
+	data, _, err := s.Get(fmt.Sprintf("v1/collectors/upgrades/%s", upgradeID), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, fmt.Errorf("collector upgrade task '%s' does not exist", upgradeID)
+	}
+
+	var response CollectorUpgradeResponse
+	err = json.Unmarshal(data, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Upgrade, nil
+}
+
+// listPageSize is the number of collectors or sources requested per page.
+var listPageSize = 1000
+
+// ListCollectors returns all collectors, requesting them a page at a time.
+// filter is passed to the API and is one of installed, hosted, alive or dead,
+// or empty for all collectors.
+func (s *Client) ListCollectors(filter string) ([]Collector, error) {
+	var collectors []Collector
+	for offset := 0; ; offset += listPageSize {
+		params := url.Values{}
+		params.Set("limit", strconv.Itoa(listPageSize))
+		params.Set("offset", strconv.Itoa(offset))
+		if filter != "" {
+			params.Set("filter", filter)
+		}
+
+		data, _, err := s.Get("v1/collectors?"+params.Encode(), false)
+		if err != nil {
+			return nil, err
+		}
+		if data == nil {
+			return collectors, nil
+		}
+
+		var response CollectorList
+		err = json.Unmarshal(data, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		collectors = append(collectors, response.Collectors...)
+		if len(response.Collectors) < listPageSize {
+			return collectors, nil
+		}
+	}
+}
+
 type CollectorRequest struct {
 	Collector Collector `json:"collector"`
 }
@@ -112,4 +194,44 @@ type Collector struct {
 	CollectorVersion string                 `json:"collectorVersion,omitempty"`
 	LastSeenAlive    int64                  `json:"lastSeenAlive,omitempty"`
 	Alive            bool                   `json:"alive,omitempty"`
+	ETag             string                 `json:"-"`
+
+	// Installed collectors only.
+	// Ephemeral and CutoffTimestamp are pointers so that false and 0 are sent
+	// for installed collectors, while hosted collectors omit them.
+	HostName        string `json:"hostName,omitempty"`
+	Ephemeral       *bool  `json:"ephemeral,omitempty"`
+	CutoffTimestamp *int64 `json:"cutoffTimestamp,omitempty"`
+	SourceSyncMode  string `json:"sourceSyncMode,omitempty"`
+	TargetCPU       int    `json:"targetCpu,omitempty"`
+}
+
+type CollectorUpgradeRequest struct {
+	CollectorID int64  `json:"collectorId"`
+	ToVersion   string `json:"toVersion"`
+}
+
+type CollectorUpgradeTaskResponse struct {
+	ID string `json:"id"`
+}
+
+type CollectorUpgradeResponse struct {
+	Upgrade CollectorUpgrade `json:"upgrade"`
+}
+
+// Status codes of a collector upgrade task.
+const (
+	collectorUpgradeNotStarted = 0
+	collectorUpgradeInProgress = 1
+	collectorUpgradeSucceeded  = 2
+	collectorUpgradeFailed     = 3
+)
+
+type CollectorUpgrade struct {
+	ID          string `json:"id"`
+	CollectorID int64  `json:"collectorId"`
+	ToVersion   string `json:"toVersion"`
+	RequestTime int64  `json:"requestTime"`
+	Status      int    `json:"status"`
+	Message     string `json:"message"`
 }
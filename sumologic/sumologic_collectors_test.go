@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -98,3 +99,36 @@ func TestGetCollectorUnauthorized(t *testing.T) {
 		t.Error("Expected GetCollector to fail, but it succeeded")
 	}
 }
+
+func TestCollectorETagRoundTrip(t *testing.T) {
+	body := `{"collector": {"id": 1234, "name": "collector1", "collectorType": "Hosted"}}`
+	httpClient := &sequenceHttpClient{
+		responses: []*http.Response{
+			newTestResponse(200, body, http.Header{"Etag": []string{`"ab12"`}}),
+			newTestResponse(200, body, nil),
+		},
+	}
+	client := newRetryTestClient(httpClient)
+
+	collector, err := client.GetCollector(1234)
+	if err != nil {
+		t.Fatalf("Expected GetCollector to succeed, received: %s", err)
+	}
+	if collector.ETag != `"ab12"` {
+		t.Fatalf("Expected the ETag to be read from the response, got %q", collector.ETag)
+	}
+
+	if err := client.UpdateCollector(*collector); err != nil {
+		t.Fatalf("Expected UpdateCollector to succeed, received: %s", err)
+	}
+	if len(httpClient.requests) != 2 {
+		t.Fatalf("Expected UpdateCollector not to fetch the ETag again, got %d requests", len(httpClient.requests))
+	}
+	if got := httpClient.requests[1].Header.Get("If-Match"); got != `"ab12"` {
+		t.Errorf("Expected If-Match %q, got %q", `"ab12"`, got)
+	}
+	sent, _ := ioutil.ReadAll(httpClient.requests[1].Body)
+	if strings.Contains(string(sent), "ab12") {
+		t.Errorf("Expected the ETag not to be serialised, got %s", sent)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 )
 
 // GetConnection returns connection information for given id
@@ -16,8 +15,7 @@ func (s *Client) GetConnection(id string) (*Connection, error) {
 
 	rawConnection, _, err := s.Get(url, false)
 	if err != nil {
-		log.Printf("SSAIN: The err: %s", err.Error())
-		if strings.Contains(err.Error(), "Connection with given ID does not exist.") {
+		if IsNotFound(err) {
 			return nil, nil
 		}
 		return nil, err
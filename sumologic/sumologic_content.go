@@ -18,7 +18,7 @@ func (s *Client) GetContent(id string, timeout time.Duration) (*Content, error)
 	// Begin the content export job
 	rawJID, err := s.Post(url, nil, false)
 	if err != nil {
-		if strings.Contains(err.Error(), "Content with the given ID does not exist.") {
+		if IsNotFound(err) {
 			return nil, nil
 		}
 		return nil, err
@@ -0,0 +1,204 @@
+package sumologic
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	defaultProfile            = "default"
+	defaultCredentialsFile    = "~/.sumologic/credentials"
+	credentialsCommandTimeout = time.Minute
+	credentialsCommandFormat  = "credentials_command must print a JSON object with access_id and access_key"
+)
+
+// credentials are the access ID and key used by the provider, along with the
+// optional deployment they belong to.
+type credentials struct {
+	AccessID    string `json:"access_id"`
+	AccessKey   string `json:"access_key"`
+	Environment string `json:"environment"`
+	BaseURL     string `json:"base_url"`
+	// Source describes where the credentials came from, for logging.
+	Source string `json:"-"`
+}
+
+// credentialsConfig holds the provider arguments that determine where the
+// credentials come from.
+type credentialsConfig struct {
+	AccessID  string
+	AccessKey string
+	// Profile is empty unless set explicitly; the default profile is only used
+	// when it exists.
+	Profile            string
+	CredentialsFile    string
+	CredentialsCommand string
+}
+
+// resolveCredentials looks the credentials up in this order and returns the
+// first source that provides them:
+//
+//  1. the access_id and access_key arguments, or the SUMOLOGIC_ACCESSID and
+//     SUMOLOGIC_ACCESSKEY environment variables,
+//  2. the output of credentials_command,
+//  3. the profile in the credentials file.
+//
+// The access ID and key always come from the same source.
+func resolveCredentials(ctx context.Context, config credentialsConfig) (credentials, error) {
+	if config.AccessID != "" || config.AccessKey != "" {
+		if config.AccessID == "" || config.AccessKey == "" {
+			return credentials{}, errors.New("sumologic provider: access_id and access_key must be set together")
+		}
+		return credentials{AccessID: config.AccessID, AccessKey: config.AccessKey, Source: "provider arguments"}, nil
+	}
+
+	if config.CredentialsCommand != "" {
+		return runCredentialsCommand(ctx, config.CredentialsCommand)
+	}
+
+	profile := config.Profile
+	if profile == "" {
+		profile = defaultProfile
+	}
+	creds, err := readCredentialsFile(config.CredentialsFile, profile)
+	if err != nil {
+		// A missing default file or profile is not an error as long as no
+		// profile was asked for explicitly.
+		if config.Profile == "" && errors.Is(err, errProfileNotFound) {
+			return credentials{}, errors.New("sumologic provider: access_id and access_key should be set")
+		}
+		return credentials{}, err
+	}
+	return creds, nil
+}
+
+var errProfileNotFound = errors.New("profile not found")
+
+// readCredentialsFile reads a profile from an INI style credentials file:
+//
+//	[default]
+//	access_id = ...
+//	access_key = ...
+//	environment = us2
+func readCredentialsFile(path, profile string) (credentials, error) {
+	if path == "" {
+		path = defaultCredentialsFile
+	}
+	path, err := expandHome(path)
+	if err != nil {
+		return credentials{}, err
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return credentials{}, fmt.Errorf("sumologic provider: %w: credentials file %s does not exist", errProfileNotFound, path)
+	}
+	if err != nil {
+		return credentials{}, fmt.Errorf("sumologic provider: failed to read credentials file: %s", err)
+	}
+
+	profiles, err := parseCredentialsFile(content)
+	if err != nil {
+		return credentials{}, fmt.Errorf("sumologic provider: invalid credentials file %s: %s", path, err)
+	}
+	values, ok := profiles[profile]
+	if !ok {
+		return credentials{}, fmt.Errorf("sumologic provider: %w: no profile %q in %s", errProfileNotFound, profile, path)
+	}
+
+	creds := credentials{
+		AccessID:    values["access_id"],
+		AccessKey:   values["access_key"],
+		Environment: values["environment"],
+		BaseURL:     values["base_url"],
+		Source:      fmt.Sprintf("profile %q in %s", profile, path),
+	}
+	if creds.AccessID == "" || creds.AccessKey == "" {
+		return credentials{}, fmt.Errorf("sumologic provider: profile %q in %s must set access_id and access_key", profile, path)
+	}
+	return creds, nil
+}
+
+func parseCredentialsFile(content []byte) (map[string]map[string]string, error) {
+	profiles := map[string]map[string]string{}
+	var current map[string]string
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			current = map[string]string{}
+			profiles[name] = current
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNumber)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: key outside of a [profile] section", lineNumber)
+		}
+		current[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return profiles, scanner.Err()
+}
+
+// runCredentialsCommand runs command through the shell and reads the
+// credentials from the JSON object it prints on stdout, e.g.
+//
+//	{"access_id": "...", "access_key": "...", "environment": "us2"}
+func runCredentialsCommand(ctx context.Context, command string) (credentials, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialsCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		// Only stderr is included: stdout may hold a partial secret.
+		return credentials{}, fmt.Errorf("sumologic provider: credentials_command failed: %s: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var creds credentials
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		return credentials{}, fmt.Errorf("sumologic provider: %s: %s", credentialsCommandFormat, err)
+	}
+	if creds.AccessID == "" || creds.AccessKey == "" {
+		return credentials{}, fmt.Errorf("sumologic provider: %s", credentialsCommandFormat)
+	}
+	creds.Source = "credentials_command"
+	return creds, nil
+}
+
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("sumologic provider: cannot locate the credentials file: %s", err)
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
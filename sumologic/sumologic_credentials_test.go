@@ -0,0 +1,117 @@
+package sumologic
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func writeCredentialsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+const testCredentialsFile = `
+# Sumo Logic credentials
+[default]
+access_id = defaultid
+access_key = defaultkey
+
+[prod]
+access_id  = prodid
+access_key = prod=key
+environment = eu
+`
+
+func TestResolveCredentialsPrefersArguments(t *testing.T) {
+	creds, err := resolveCredentials(context.Background(), credentialsConfig{
+		AccessID:           "argid",
+		AccessKey:          "argkey",
+		Profile:            "prod",
+		CredentialsFile:    writeCredentialsFile(t, testCredentialsFile),
+		CredentialsCommand: "exit 1",
+	})
+	if err != nil {
+		t.Fatalf("Expected credentials, received: %s", err)
+	}
+	if creds.AccessID != "argid" || creds.AccessKey != "argkey" {
+		t.Errorf("Expected the provider arguments to win, got %+v", creds)
+	}
+}
+
+func TestResolveCredentialsRequiresBothKeys(t *testing.T) {
+	_, err := resolveCredentials(context.Background(), credentialsConfig{AccessID: "argid"})
+	if err == nil || !strings.Contains(err.Error(), "set together") {
+		t.Errorf("Expected an error for a lone access_id, got %v", err)
+	}
+}
+
+func TestResolveCredentialsFromProfile(t *testing.T) {
+	path := writeCredentialsFile(t, testCredentialsFile)
+
+	creds, err := resolveCredentials(context.Background(), credentialsConfig{CredentialsFile: path})
+	if err != nil {
+		t.Fatalf("Expected credentials, received: %s", err)
+	}
+	if creds.AccessID != "defaultid" || creds.AccessKey != "defaultkey" {
+		t.Errorf("Expected the default profile, got %+v", creds)
+	}
+
+	creds, err = resolveCredentials(context.Background(), credentialsConfig{CredentialsFile: path, Profile: "prod"})
+	if err != nil {
+		t.Fatalf("Expected credentials, received: %s", err)
+	}
+	if creds.AccessID != "prodid" || creds.AccessKey != "prod=key" || creds.Environment != "eu" {
+		t.Errorf("Expected the prod profile, got %+v", creds)
+	}
+}
+
+func TestResolveCredentialsMissingProfile(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "credentials")
+
+	_, err := resolveCredentials(context.Background(), credentialsConfig{CredentialsFile: missing})
+	if err == nil || !strings.Contains(err.Error(), "should be set") {
+		t.Errorf("Expected a missing default file to ask for credentials, got %v", err)
+	}
+
+	_, err = resolveCredentials(context.Background(), credentialsConfig{
+		CredentialsFile: writeCredentialsFile(t, testCredentialsFile),
+		Profile:         "staging",
+	})
+	if err == nil || !strings.Contains(err.Error(), `no profile "staging"`) {
+		t.Errorf("Expected an explicit profile to be required, got %v", err)
+	}
+}
+
+func TestResolveCredentialsFromCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+
+	creds, err := resolveCredentials(context.Background(), credentialsConfig{
+		CredentialsFile:    writeCredentialsFile(t, testCredentialsFile),
+		CredentialsCommand: `echo '{"access_id": "cmdid", "access_key": "cmdkey", "base_url": "https://api.example.com/api/"}'`,
+	})
+	if err != nil {
+		t.Fatalf("Expected credentials, received: %s", err)
+	}
+	if creds.AccessID != "cmdid" || creds.AccessKey != "cmdkey" || creds.BaseURL != "https://api.example.com/api/" {
+		t.Errorf("Expected the command output to win over the profile, got %+v", creds)
+	}
+
+	_, err = resolveCredentials(context.Background(), credentialsConfig{CredentialsCommand: "echo denied >&2; exit 3"})
+	if err == nil || !strings.Contains(err.Error(), "denied") {
+		t.Errorf("Expected the command's stderr in the error, got %v", err)
+	}
+
+	_, err = resolveCredentials(context.Background(), credentialsConfig{CredentialsCommand: `echo '{"access_id": "cmdid"}'`})
+	if err == nil || !strings.Contains(err.Error(), "must print") {
+		t.Errorf("Expected incomplete output to be rejected, got %v", err)
+	}
+}
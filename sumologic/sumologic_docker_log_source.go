@@ -0,0 +1,85 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type DockerLogSource struct {
+	Source
+	URI                 string   `json:"uri"`
+	SpecifiedContainers []string `json:"specifiedContainers,omitempty"`
+	AllContainers       bool     `json:"allContainers"`
+	CertPath            string   `json:"certPath,omitempty"`
+	CollectEvents       bool     `json:"collectEvents"`
+}
+
+func (s *Client) CreateDockerLogSource(dockerLogSource DockerLogSource, collectorID int) (int, error) {
+
+	type DockerLogSourceMessage struct {
+		Source DockerLogSource `json:"source"`
+	}
+
+	request := DockerLogSourceMessage{
+		Source: dockerLogSource,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources", collectorID)
+	body, err := s.Post(urlPath, request, false)
+
+	if err != nil {
+		return -1, err
+	}
+
+	var response DockerLogSourceMessage
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return -1, err
+	}
+
+	return response.Source.ID, nil
+}
+
+func (s *Client) GetDockerLogSource(collectorID, sourceID int) (*DockerLogSource, error) {
+
+	body, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if body == nil {
+		return nil, nil
+	}
+
+	type Response struct {
+		Source DockerLogSource `json:"source"`
+	}
+
+	var response Response
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Source.ETag = etag
+
+	return &response.Source, nil
+}
+
+func (s *Client) UpdateDockerLogSource(source DockerLogSource, collectorID int) error {
+
+	type DockerLogSourceMessage struct {
+		Source DockerLogSource `json:"source"`
+	}
+
+	request := DockerLogSourceMessage{
+		Source: source,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, source.ID)
+	_, err := s.PutWithETag(urlPath, request, source.ETag, false)
+
+	return err
+}
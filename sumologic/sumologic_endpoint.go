@@ -0,0 +1,93 @@
+package sumologic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// discoveryURL is the us1 API endpoint, which redirects requests made with
+// credentials of any other deployment to that deployment's endpoint.
+const discoveryURL = "https://api.sumologic.com/api/v1/collectors"
+
+// endpointResolver returns the API base URL of the deployment that accessID
+// belongs to. It is used when neither environment nor base_url is set.
+type endpointResolver func(ctx context.Context, httpClient *http.Client, accessID, accessKey string) (string, error)
+
+// discoverEndpoint is the endpointResolver used by the provider. It asks the
+// us1 endpoint and follows its redirect, if any.
+func discoverEndpoint(ctx context.Context, httpClient *http.Client, accessID, accessKey string) (string, error) {
+	return discoverEndpointAt(ctx, httpClient, discoveryURL, accessID, accessKey)
+}
+
+func discoverEndpointAt(ctx context.Context, httpClient *http.Client, url, accessID, accessKey string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(accessID, accessKey)
+	client := *httpClient
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+
+	if location := resp.Header.Get("Location"); location != "" {
+		return strings.Split(location, "v1")[0], nil
+	}
+	if resp.StatusCode >= 300 {
+		// Without a redirect only a successful answer proves that the
+		// credentials belong to us1; anything else would be a guess.
+		return "", fmt.Errorf("%s %s returned %d %s without a redirect", req.Method, url, resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	return strings.Split(url, "v1")[0], nil
+}
+
+// endpointCache remembers the endpoints resolved for each access ID, so that
+// provider configurations sharing credentials only discover it once.
+type endpointCache struct {
+	mu        sync.Mutex
+	endpoints map[string]string
+}
+
+var resolvedEndpoints = &endpointCache{endpoints: map[string]string{}}
+
+// cached wraps resolver so that successful resolutions are served from the
+// cache. Failures are not cached.
+func (c *endpointCache) cached(resolver endpointResolver) endpointResolver {
+	return func(ctx context.Context, httpClient *http.Client, accessID, accessKey string) (string, error) {
+		c.mu.Lock()
+		endpoint, ok := c.endpoints[accessID]
+		c.mu.Unlock()
+		if ok {
+			return endpoint, nil
+		}
+
+		endpoint, err := resolver(ctx, httpClient, accessID, accessKey)
+		if err != nil {
+			return "", err
+		}
+
+		c.mu.Lock()
+		c.endpoints[accessID] = endpoint
+		c.mu.Unlock()
+		return endpoint, nil
+	}
+}
+
+// environments returns the names of the known deployments, sorted.
+func environments() []string {
+	names := make([]string, 0, len(endpoints))
+	for name := range endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
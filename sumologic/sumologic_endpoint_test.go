@@ -0,0 +1,125 @@
+package sumologic
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestDiscoverEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, _, _ := r.BasicAuth()
+		switch id {
+		case "us2id":
+			w.Header().Set("Location", "https://api.us2.sumologic.com/api/v1/collectors")
+			w.WriteHeader(http.StatusMovedPermanently)
+		case "us1id":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+	url := server.URL + "/api/v1/collectors"
+
+	endpoint, err := discoverEndpointAt(context.Background(), server.Client(), url, "us2id", "key")
+	if err != nil || endpoint != "https://api.us2.sumologic.com/api/" {
+		t.Errorf("Expected the redirect target, got %q, %v", endpoint, err)
+	}
+
+	endpoint, err = discoverEndpointAt(context.Background(), server.Client(), url, "us1id", "key")
+	if err != nil || endpoint != server.URL+"/api/" {
+		t.Errorf("Expected the discovery endpoint itself, got %q, %v", endpoint, err)
+	}
+
+	if _, err = discoverEndpointAt(context.Background(), server.Client(), url, "unknown", "key"); err == nil {
+		t.Error("Expected rejected credentials not to resolve to us1")
+	}
+}
+
+func TestEndpointCache(t *testing.T) {
+	calls := 0
+	fail := true
+	resolver := func(ctx context.Context, httpClient *http.Client, accessID, accessKey string) (string, error) {
+		calls++
+		if fail {
+			return "", errors.New("unreachable")
+		}
+		return "https://api.eu.sumologic.com/api/", nil
+	}
+	cache := &endpointCache{endpoints: map[string]string{}}
+	resolve := cache.cached(resolver)
+
+	if _, err := resolve(context.Background(), nil, "id", "key"); err == nil {
+		t.Fatal("Expected the failure to be returned")
+	}
+	fail = false
+	for i := 0; i < 3; i++ {
+		if endpoint, err := resolve(context.Background(), nil, "id", "key"); err != nil || endpoint != "https://api.eu.sumologic.com/api/" {
+			t.Fatalf("Expected the resolved endpoint, got %q, %v", endpoint, err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Expected failures not to be cached and successes to be, got %d calls", calls)
+	}
+}
+
+func testProviderData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, raw)
+}
+
+func TestProviderConfigureReportsDiscoveryFailures(t *testing.T) {
+	resolver := func(ctx context.Context, httpClient *http.Client, accessID, accessKey string) (string, error) {
+		return "", errors.New("connection refused")
+	}
+	d := testProviderData(t, map[string]interface{}{"access_id": "id", "access_key": "key"})
+
+	_, err := providerConfigure(d, context.Background(), resolver)
+	if err == nil || !strings.Contains(err.Error(), "set environment or base_url") {
+		t.Errorf("Expected an explicit discovery error, got %v", err)
+	}
+}
+
+func TestProviderConfigureUsesResolvedEndpoint(t *testing.T) {
+	calls := 0
+	resolver := func(ctx context.Context, httpClient *http.Client, accessID, accessKey string) (string, error) {
+		calls++
+		return "https://api.jp.sumologic.com/api/", nil
+	}
+
+	d := testProviderData(t, map[string]interface{}{"access_id": "id", "access_key": "key"})
+	meta, err := providerConfigure(d, context.Background(), resolver)
+	if err != nil {
+		t.Fatalf("Expected configure to succeed, received: %s", err)
+	}
+	if got := meta.(*Client).BaseURL.String(); got != "https://api.jp.sumologic.com/api/" {
+		t.Errorf("Expected the resolved endpoint, got %s", got)
+	}
+
+	d = testProviderData(t, map[string]interface{}{"access_id": "id", "access_key": "key", "environment": "eu"})
+	meta, err = providerConfigure(d, context.Background(), resolver)
+	if err != nil {
+		t.Fatalf("Expected configure to succeed, received: %s", err)
+	}
+	if got := meta.(*Client).BaseURL.String(); got != endpoints["eu"] {
+		t.Errorf("Expected the environment's endpoint, got %s", got)
+	}
+	if calls != 1 {
+		t.Errorf("Expected no discovery when environment is set, got %d calls", calls)
+	}
+}
+
+func TestProviderValidatesEnvironment(t *testing.T) {
+	validate := Provider().(*schema.Provider).Schema["environment"].ValidateFunc
+	if _, errs := validate("eu", "environment"); len(errs) != 0 {
+		t.Errorf("Expected eu to be valid, got %v", errs)
+	}
+	if _, errs := validate("us3", "environment"); len(errs) == 0 {
+		t.Error("Expected us3 to be rejected")
+	}
+}
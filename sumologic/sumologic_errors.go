@@ -0,0 +1,155 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned by the Client for every response with a status code of
+// 400 or above.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	// RequestID identifies the failed request in Sumo Logic support tickets.
+	RequestID string
+	Code      string
+	Message   string
+	Detail    string
+	// Errors holds every error reported by the API; Code, Message and Detail
+	// are copied from the first one.
+	Errors []Error
+	// Body is the raw response body, kept for responses that are not JSON.
+	Body string
+}
+
+// apiErrorResponse covers both error formats returned by the API: the list
+// based one used by most endpoints and the flat one used by the collector
+// management API.
+type apiErrorResponse struct {
+	ID      string  `json:"id"`
+	Errors  []Error `json:"errors"`
+	Code    string  `json:"code"`
+	Message string  `json:"message"`
+	Detail  string  `json:"detail"`
+}
+
+func newAPIError(method, url string, resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		URL:        url,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Body:       string(body),
+	}
+
+	var parsed apiErrorResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return apiErr
+	}
+
+	if parsed.ID != "" {
+		apiErr.RequestID = parsed.ID
+	}
+	apiErr.Errors = parsed.Errors
+	if len(apiErr.Errors) == 0 && (parsed.Code != "" || parsed.Message != "") {
+		apiErr.Errors = []Error{{Code: parsed.Code, Message: parsed.Message, Detail: parsed.Detail}}
+	}
+	if len(apiErr.Errors) > 0 {
+		apiErr.Code = apiErr.Errors[0].Code
+		apiErr.Message = apiErr.Errors[0].Message
+		apiErr.Detail = apiErr.Errors[0].Detail
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s returned %d %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+
+	if len(e.Errors) == 0 {
+		if body := strings.TrimSpace(e.Body); body != "" {
+			fmt.Fprintf(&b, ": %s", body)
+		}
+	}
+	for _, apiErr := range e.Errors {
+		b.WriteString(": ")
+		if apiErr.Code != "" {
+			fmt.Fprintf(&b, "[%s] ", apiErr.Code)
+		}
+		b.WriteString(apiErr.Message)
+		if apiErr.Detail != "" {
+			fmt.Fprintf(&b, " (%s)", apiErr.Detail)
+		}
+	}
+
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " (request id: %s)", e.RequestID)
+	}
+	return b.String()
+}
+
+// HasCode reports whether the API returned the given Sumo Logic error code.
+func (e *APIError) HasCode(code string) bool {
+	for _, apiErr := range e.Errors {
+		if apiErr.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+func asAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	ok := errors.As(err, &apiErr)
+	return apiErr, ok
+}
+
+// IsNotFound reports whether err means the requested object does not exist.
+// Some endpoints answer 400 rather than 404 for unknown IDs, so the error code
+// is checked as well.
+func IsNotFound(err error) bool {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusNotFound {
+		return true
+	}
+	for _, e := range apiErr.Errors {
+		if strings.HasSuffix(e.Code, "not_found") || strings.HasSuffix(e.Code, "doesnt_exist") ||
+			strings.HasSuffix(e.Code, "does_not_exist") {
+			return true
+		}
+	}
+	return false
+}
+
+// IsConflict reports whether err is a 409 Conflict.
+func IsConflict(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusConflict
+}
+
+// IsPreconditionFailed reports whether err is a 412 Precondition Failed, i.e.
+// the If-Match header of an update did not match the current ETag.
+func IsPreconditionFailed(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusPreconditionFailed
+}
+
+// IsRateLimited reports whether err is a 429 Too Many Requests.
+func IsRateLimited(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusTooManyRequests
+}
+
+// hasErrorCode reports whether err is an APIError carrying the given code.
+func hasErrorCode(err error, code string) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.HasCode(code)
+}
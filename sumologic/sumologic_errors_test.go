@@ -0,0 +1,106 @@
+package sumologic
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAPIErrorFromErrorList(t *testing.T) {
+	body := `{
+		"id": "8ZX3L-QW1KA-71ABC",
+		"errors": [
+			{
+				"code": "content:doesnt_exist",
+				"message": "Content with the given ID does not exist.",
+				"detail": "id 0000000000ABC123"
+			}
+		]
+	}`
+	client := newTestClient(newTestResponse(400, body, nil))
+
+	_, err := client.Post("v2/content/0000000000ABC123/export", nil, false)
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("Expected an *APIError, got %T: %v", err, err)
+	}
+
+	if apiErr.StatusCode != 400 || apiErr.Method != http.MethodPost ||
+		!strings.HasSuffix(apiErr.URL, "v2/content/0000000000ABC123/export") {
+		t.Errorf("Unexpected request details: %+v", apiErr)
+	}
+	if apiErr.Code != "content:doesnt_exist" || apiErr.Message != "Content with the given ID does not exist." ||
+		apiErr.Detail != "id 0000000000ABC123" || apiErr.RequestID != "8ZX3L-QW1KA-71ABC" {
+		t.Errorf("Unexpected error details: %+v", apiErr)
+	}
+	if !IsNotFound(err) {
+		t.Error("Expected IsNotFound to recognize the doesnt_exist error code")
+	}
+
+	msg := err.Error()
+	for _, part := range []string{"POST", "400", "[content:doesnt_exist]", "Content with the given ID does not exist.", "8ZX3L-QW1KA-71ABC"} {
+		if !strings.Contains(msg, part) {
+			t.Errorf("Expected %q in error message %q", part, msg)
+		}
+	}
+}
+
+func TestAPIErrorFromFlatError(t *testing.T) {
+	body := `{
+		"status": 400,
+		"id": "IUUQI-DGH5I-TJ045",
+		"code": "collectors.validation.fields.invalid",
+		"message": "Invalid field value."
+	}`
+	client := newTestClient(newTestResponse(400, body, nil))
+
+	_, err := client.Delete("v1/collectors/1")
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("Expected an *APIError, got %T: %v", err, err)
+	}
+	if apiErr.Code != "collectors.validation.fields.invalid" || apiErr.Message != "Invalid field value." ||
+		apiErr.RequestID != "IUUQI-DGH5I-TJ045" {
+		t.Errorf("Unexpected error details: %+v", apiErr)
+	}
+	if !apiErr.HasCode("collectors.validation.fields.invalid") {
+		t.Error("Expected HasCode to match the flat error code")
+	}
+	if IsNotFound(err) || IsConflict(err) || IsRateLimited(err) {
+		t.Error("Expected a plain validation error")
+	}
+}
+
+func TestAPIErrorWithoutJSONBody(t *testing.T) {
+	client := newTestClient(newTestResponse(401, "<html></html>", nil))
+
+	_, err := client.Delete("v1/collectors/1")
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("Expected an *APIError, got %T: %v", err, err)
+	}
+	if apiErr.Body != "<html></html>" || !strings.Contains(err.Error(), "<html></html>") {
+		t.Errorf("Expected the raw body to be kept, got %q", err.Error())
+	}
+}
+
+func TestAPIErrorHelpers(t *testing.T) {
+	notFound := &APIError{StatusCode: 404}
+	conflict := &APIError{StatusCode: 409}
+	rateLimited := &APIError{StatusCode: 429}
+	wrapped := fmt.Errorf("reading collector: %w", conflict)
+
+	if !IsNotFound(notFound) || IsNotFound(conflict) {
+		t.Error("IsNotFound mismatch")
+	}
+	if !IsConflict(conflict) || !IsConflict(wrapped) || IsConflict(notFound) {
+		t.Error("IsConflict mismatch")
+	}
+	if !IsRateLimited(rateLimited) || IsRateLimited(notFound) {
+		t.Error("IsRateLimited mismatch")
+	}
+	if IsNotFound(fmt.Errorf("plain error")) || IsNotFound(nil) {
+		t.Error("Expected non API errors not to match")
+	}
+}
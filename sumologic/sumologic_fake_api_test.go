@@ -0,0 +1,645 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// fakeSumoAPI is an in-memory implementation of the endpoints used by Client:
+// collectors, sources, roles, users, folders, content, monitors, SLOs, muting
+// schedules, dashboards and search jobs. It stores whatever it is sent and
+// echoes it back, which is enough for the provider to go through create,
+// read, update, import and delete without a real Sumo Logic organization. The
+// TestUnit tests of the resources run against it.
+//
+// Objects are stored by their API path, e.g. "v1/roles/0000000000000001", so
+// tests can change or remove them behind the provider's back to exercise
+// drift detection.
+type fakeSumoAPI struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	lastID   int
+	objects  map[string]map[string]interface{}
+	versions map[string]int
+	jobs     map[string]fakeJob
+	// seeded objects exist before the test and may outlive it.
+	seeded map[string]bool
+	// messages are the times of the log messages found by search jobs,
+	// whatever their query.
+	messages []time.Time
+}
+
+// fakeHandler answers a request with a status code and a response to encode
+// as JSON, or an error code for statuses of 400 and above. match holds the
+// submatches of the route's pattern.
+type fakeHandler func(api *fakeSumoAPI, r *http.Request, body map[string]interface{}, match []string) (int, interface{})
+
+type fakeRoute struct {
+	pattern *regexp.Regexp
+	handler fakeHandler
+}
+
+// fakeJob is an asynchronous content job, which the fake API completes
+// immediately.
+type fakeJob struct {
+	status Status
+	result interface{}
+}
+
+var fakeRoutes = []fakeRoute{
+	{regexp.MustCompile(`^v1/collectors$`), fakeCollectionHandler("collector", true)},
+	{regexp.MustCompile(`^v1/collectors/name/([^/]+)$`), (*fakeSumoAPI).handleCollectorByName},
+	{regexp.MustCompile(`^v1/collectors/upgrades$`), (*fakeSumoAPI).handleCollectorUpgrade},
+	{regexp.MustCompile(`^v1/collectors/upgrades/(\w+)$`), (*fakeSumoAPI).handleCollectorUpgradeStatus},
+	{regexp.MustCompile(`^v1/collectors/\d+$`), fakeObjectHandler("collector")},
+	{regexp.MustCompile(`^v1/collectors/\d+/sources$`), fakeCollectionHandler("source", true)},
+	{regexp.MustCompile(`^v1/collectors/\d+/sources/\d+$`), fakeObjectHandler("source")},
+	{regexp.MustCompile(`^(v1/collectors/\d+/sources/\d+)/action/createNewUrl$`), (*fakeSumoAPI).handleSourceNewURL},
+	{regexp.MustCompile(`^v1/(roles|users)$`), fakeCollectionHandler("", false)},
+	{regexp.MustCompile(`^v1/roles/\w+$`), fakeObjectHandler("")},
+	{regexp.MustCompile(`^v1/users/\w+$`), fakeObjectHandler("", "email")},
+	{regexp.MustCompile(`^v1/search/jobs$`), (*fakeSumoAPI).handleSearchJobCreate},
+	{regexp.MustCompile(`^v1/search/jobs/(\w+)$`), (*fakeSumoAPI).handleSearchJob},
+	{regexp.MustCompile(`^v1/search/jobs/(\w+)/records$`), (*fakeSumoAPI).handleSearchJobRecords},
+	{regexp.MustCompile(`^v2/dashboards$`), fakeCollectionHandler("", false)},
+	{regexp.MustCompile(`^v2/dashboards/\w+$`), fakeObjectHandler("")},
+	{regexp.MustCompile(`^v1/(monitors|slos)$`), (*fakeSumoAPI).handleLibraryCreate},
+	{regexp.MustCompile(`^v1/(monitors|slos)/\w+$`), (*fakeSumoAPI).handleLibraryItem},
+	{regexp.MustCompile(`^v1/mutingSchedules$`), fakeCollectionHandler("", false)},
+	{regexp.MustCompile(`^v1/mutingSchedules/\w+$`), fakeObjectHandler("")},
+	{regexp.MustCompile(`^v2/content/folders$`), (*fakeSumoAPI).handleFolderCreate},
+	{regexp.MustCompile(`^v2/content/folders/personal$`), (*fakeSumoAPI).handlePersonalFolder},
+	{regexp.MustCompile(`^v2/content/folders/(\w+)/import$`), (*fakeSumoAPI).handleContentImport},
+	{regexp.MustCompile(`^v2/content/folders/\w+$`), (*fakeSumoAPI).handleFolder},
+	{regexp.MustCompile(`^v2/content/(\w+)/export$`), (*fakeSumoAPI).handleContentExport},
+	{regexp.MustCompile(`^v2/content/(\w+)/delete$`), (*fakeSumoAPI).handleContentDelete},
+	{regexp.MustCompile(`^v2/content/.+/(\w+)/status$`), (*fakeSumoAPI).handleJobStatus},
+	{regexp.MustCompile(`^v2/content/\w+/export/(\w+)/result$`), (*fakeSumoAPI).handleJobResult},
+}
+
+const (
+	fakePersonalFolderID = "00000000000000F0"
+	fakeMonitorsRootID   = "00000000000000F1"
+	fakeSlosRootID       = "00000000000000F2"
+)
+
+// fakeLibraryRootIDs are the root folders of the monitors and SLO libraries.
+var fakeLibraryRootIDs = map[string]string{"monitors": fakeMonitorsRootID, "slos": fakeSlosRootID}
+
+func newFakeSumoAPI(t *testing.T) *fakeSumoAPI {
+	api := &fakeSumoAPI{
+		objects:  map[string]map[string]interface{}{},
+		versions: map[string]int{},
+		jobs:     map[string]fakeJob{},
+		seeded:   map[string]bool{},
+	}
+	api.seed("v2/content/"+fakePersonalFolderID, map[string]interface{}{
+		"id": fakePersonalFolderID, "name": "Personal", "description": "", "parentId": "0000000000000000",
+	})
+	api.seed("v1/monitors/"+fakeMonitorsRootID, map[string]interface{}{
+		"id": fakeMonitorsRootID, "name": "Root", "type": "MonitorsLibraryFolder", "parentId": "",
+	})
+	api.seed("v1/slos/"+fakeSlosRootID, map[string]interface{}{
+		"id": fakeSlosRootID, "name": "Root", "type": "SlosLibraryFolder", "parentId": "",
+	})
+	api.server = httptest.NewServer(http.HandlerFunc(api.serveHTTP))
+	t.Cleanup(api.server.Close)
+	return api
+}
+
+// providerConfig returns a provider block pointing at the fake API.
+func (api *fakeSumoAPI) providerConfig() string {
+	return fmt.Sprintf(`
+provider "sumologic" {
+  access_id           = "fakeaccessid"
+  access_key          = "fakeaccesskey"
+  base_url            = "%s/api/"
+  requests_per_minute = 0
+  max_retries         = 0
+}
+`, api.server.URL)
+}
+
+// unitTest runs a resource.UnitTest against the fake API. Every step's
+// configuration, including that of import steps, is prefixed with the
+// provider block, and the test fails if any object is left behind.
+func (api *fakeSumoAPI) unitTest(t *testing.T, steps ...resource.TestStep) {
+	for i := range steps {
+		steps[i].Config = api.providerConfig() + steps[i].Config
+	}
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    map[string]terraform.ResourceProvider{"sumologic": Provider()},
+		CheckDestroy: api.checkDestroyed,
+		Steps:        steps,
+	})
+}
+
+func (api *fakeSumoAPI) checkDestroyed(*terraform.State) error {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	for path := range api.objects {
+		if !api.seeded[path] {
+			return fmt.Errorf("%s still exists", path)
+		}
+	}
+	return nil
+}
+
+// seed stores an object that exists independently of the test, e.g. an
+// installed collector registered by a host.
+func (api *fakeSumoAPI) seed(path string, object map[string]interface{}) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.store(path, object)
+	api.seeded[path] = true
+}
+
+// update changes a stored object as if it was edited outside Terraform.
+func (api *fakeSumoAPI) update(path string, change func(object map[string]interface{})) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	change(api.objects[path])
+	api.versions[path]++
+}
+
+// remove deletes a stored object as if it was deleted outside Terraform.
+func (api *fakeSumoAPI) remove(path string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	delete(api.objects, path)
+}
+
+// checkObject returns a TestCheckFunc asserting that the object stored at the
+// path built from the resource's attributes has the given field values.
+func (api *fakeSumoAPI) checkObject(name string, path func(attributes map[string]string) string, fields map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found", name)
+		}
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		objectPath := path(rs.Primary.Attributes)
+		object, ok := api.objects[objectPath]
+		if !ok {
+			return fmt.Errorf("%s was not created", objectPath)
+		}
+		for field, expected := range fields {
+			if fmt.Sprint(object[field]) != fmt.Sprint(expected) {
+				return fmt.Errorf("%s: expected %s to be %v, got %v", objectPath, field, expected, object[field])
+			}
+		}
+		return nil
+	}
+}
+
+// testImportStateIdFunc returns the import ID of a resource, its attributes
+// joined by /, e.g. collector_id/id.
+func testImportStateIdFunc(name string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", name)
+		}
+		parts := make([]string, len(attributes))
+		for i, attribute := range attributes {
+			parts[i] = rs.Primary.Attributes[attribute]
+		}
+		return strings.Join(parts, "/"), nil
+	}
+}
+
+func (api *fakeSumoAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/api/")
+
+	var body map[string]interface{}
+	if raw, _ := ioutil.ReadAll(r.Body); len(raw) > 0 {
+		if err := json.Unmarshal(raw, &body); err != nil {
+			api.writeError(w, http.StatusBadRequest, "api:invalid_json", err.Error())
+			return
+		}
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	for _, route := range fakeRoutes {
+		if match := route.pattern.FindStringSubmatch(path); match != nil {
+			status, response := route.handler(api, r, body, match)
+			if status >= 400 {
+				api.writeError(w, status, fmt.Sprint(response), http.StatusText(status))
+				return
+			}
+			if version, ok := api.versions[path]; ok {
+				w.Header().Set("ETag", strconv.Quote(strconv.Itoa(version)))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			if response != nil {
+				json.NewEncoder(w).Encode(response)
+			}
+			return
+		}
+	}
+	api.writeError(w, http.StatusNotFound, "api:not_implemented", r.Method+" "+path+" is not implemented by the fake API")
+}
+
+func (api *fakeSumoAPI) writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":     "FAKE-REQUEST",
+		"errors": []Error{{Code: code, Message: message}},
+	})
+}
+
+func (api *fakeSumoAPI) newID(numeric bool) interface{} {
+	api.lastID++
+	if numeric {
+		return api.lastID
+	}
+	return fmt.Sprintf("%016X", api.lastID)
+}
+
+func (api *fakeSumoAPI) store(path string, object map[string]interface{}) {
+	api.objects[path] = object
+	api.versions[path]++
+}
+
+func wrap(wrapper string, object interface{}) interface{} {
+	if wrapper == "" {
+		return object
+	}
+	return map[string]interface{}{wrapper: object}
+}
+
+func unwrap(wrapper string, body map[string]interface{}) map[string]interface{} {
+	if wrapper == "" {
+		return body
+	}
+	object, _ := body[wrapper].(map[string]interface{})
+	return object
+}
+
+// fakeCollectionHandler creates objects with POST and lists them with GET. The
+// collector management API wraps objects, e.g. {"collector": {...}}, and uses
+// numeric IDs.
+func fakeCollectionHandler(wrapper string, numeric bool) fakeHandler {
+	return func(api *fakeSumoAPI, r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+		switch r.Method {
+		case http.MethodPost:
+			object := unwrap(wrapper, body)
+			if object == nil {
+				return http.StatusBadRequest, "api:invalid_body"
+			}
+			object["id"] = api.newID(numeric)
+			api.store(fmt.Sprintf("%s/%v", match[0], object["id"]), object)
+			return http.StatusOK, wrap(wrapper, object)
+		case http.MethodGet:
+			objects := []map[string]interface{}{}
+			for path, object := range api.objects {
+				if strings.HasPrefix(path, match[0]+"/") && !strings.Contains(strings.TrimPrefix(path, match[0]+"/"), "/") &&
+					fakeCollectorFilter(r.URL.Query().Get("filter"), object) {
+					objects = append(objects, object)
+				}
+			}
+			// Page in ID order, shorter IDs first so that numeric IDs sort
+			// numerically.
+			sort.Slice(objects, func(i, j int) bool {
+				a, b := fmt.Sprint(objects[i]["id"]), fmt.Sprint(objects[j]["id"])
+				return len(a) < len(b) || len(a) == len(b) && a < b
+			})
+			query := r.URL.Query()
+			if offset, err := strconv.Atoi(query.Get("offset")); err == nil && offset < len(objects) {
+				objects = objects[offset:]
+			} else if err == nil {
+				objects = objects[:0]
+			}
+			if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit < len(objects) {
+				objects = objects[:limit]
+			}
+			return http.StatusOK, map[string]interface{}{wrapper + "s": objects}
+		}
+		return http.StatusMethodNotAllowed, "api:method_not_allowed"
+	}
+}
+
+// fakeCollectorFilter implements the filter parameter of the collector list.
+func fakeCollectorFilter(filter string, object map[string]interface{}) bool {
+	switch filter {
+	case "installed":
+		return object["collectorType"] == "Installable"
+	case "hosted":
+		return object["collectorType"] == "Hosted"
+	case "alive":
+		return object["alive"] == true
+	case "dead":
+		return object["alive"] != true
+	}
+	return true
+}
+
+// fakeObjectHandler reads, updates and deletes a single object. Updates honor
+// If-Match against the object's version and ignore the readOnly fields.
+func fakeObjectHandler(wrapper string, readOnly ...string) fakeHandler {
+	return func(api *fakeSumoAPI, r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+		path := match[0]
+		object, ok := api.objects[path]
+		if !ok {
+			return http.StatusNotFound, "api:not_found"
+		}
+		switch r.Method {
+		case http.MethodGet:
+			return http.StatusOK, wrap(wrapper, object)
+		case http.MethodPut:
+			if etag := r.Header.Get("If-Match"); etag != "" && etag != strconv.Quote(strconv.Itoa(api.versions[path])) {
+				return http.StatusPreconditionFailed, "api:etag_mismatch"
+			}
+			changes := unwrap(wrapper, body)
+			if changes == nil {
+				return http.StatusBadRequest, "api:invalid_body"
+			}
+			// Like the real API, keep the fields that are not sent, e.g. the
+			// email of a user.
+			updated := map[string]interface{}{}
+			for field, value := range object {
+				updated[field] = value
+			}
+			for field, value := range changes {
+				updated[field] = value
+			}
+			for _, field := range readOnly {
+				updated[field] = object[field]
+			}
+			updated["id"] = object["id"]
+			api.store(path, updated)
+			return http.StatusOK, wrap(wrapper, updated)
+		case http.MethodDelete:
+			for child := range api.objects {
+				if strings.HasPrefix(child, path+"/") {
+					delete(api.objects, child)
+				}
+			}
+			delete(api.objects, path)
+			return http.StatusNoContent, nil
+		}
+		return http.StatusMethodNotAllowed, "api:method_not_allowed"
+	}
+}
+
+func (api *fakeSumoAPI) handleCollectorByName(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	for path, object := range api.objects {
+		if regexp.MustCompile(`^v1/collectors/\d+$`).MatchString(path) && object["name"] == match[1] {
+			return http.StatusOK, wrap("collector", object)
+		}
+	}
+	return http.StatusNotFound, "collector:not_found"
+}
+
+// handleSourceNewURL gives an HTTP source a new URL, and token if it uses
+// token authentication.
+func (api *fakeSumoAPI) handleSourceNewURL(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	source, ok := api.objects[match[1]]
+	if !ok {
+		return http.StatusNotFound, "api:not_found"
+	}
+	generation := api.newID(true)
+	if source["authMethod"] == "token" {
+		source["url"] = "https://collectors.sumologic.com/receiver/v1/http"
+		source["token"] = fmt.Sprintf("token-%v", generation)
+	} else {
+		source["url"] = fmt.Sprintf("https://collectors.sumologic.com/receiver/v1/http/url-%v", generation)
+	}
+	api.versions[match[1]]++
+	return http.StatusOK, wrap("source", source)
+}
+
+// The monitors and SLO libraries hold folders and items under v1/{library}.
+func (api *fakeSumoAPI) handleLibraryCreate(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	if r.Method != http.MethodPost {
+		return http.StatusMethodNotAllowed, "api:method_not_allowed"
+	}
+	body["id"] = api.newID(false)
+	body["parentId"] = r.URL.Query().Get("parentId")
+	api.store(fmt.Sprintf("v1/%s/%s", match[1], body["id"]), body)
+	return http.StatusOK, body
+}
+
+func (api *fakeSumoAPI) handleLibraryItem(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	path := match[0]
+	if path == fmt.Sprintf("v1/%s/root", match[1]) {
+		path = fmt.Sprintf("v1/%s/%s", match[1], fakeLibraryRootIDs[match[1]])
+	}
+	object, ok := api.objects[path]
+	if !ok {
+		return http.StatusNotFound, "api:not_found"
+	}
+	if r.Method == http.MethodPut {
+		// Updates are sent as e.g. MonitorsLibraryMonitorUpdate.
+		if kind, ok := body["type"].(string); ok {
+			body["type"] = strings.TrimSuffix(kind, "Update")
+		}
+		body["id"] = object["id"]
+		body["parentId"] = object["parentId"]
+		if parentID := r.URL.Query().Get("parentId"); parentID != "" {
+			body["parentId"] = parentID
+		}
+		api.store(path, body)
+		return http.StatusOK, body
+	}
+	return fakeObjectHandler("")(api, r, body, []string{path})
+}
+
+// Folders and content share the v2/content/{id} namespace.
+func (api *fakeSumoAPI) handleFolderCreate(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	body["id"] = api.newID(false)
+	api.store(fmt.Sprintf("v2/content/%s", body["id"]), body)
+	return http.StatusOK, body
+}
+
+func (api *fakeSumoAPI) handlePersonalFolder(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	return http.StatusOK, api.objects["v2/content/"+fakePersonalFolderID]
+}
+
+func (api *fakeSumoAPI) handleFolder(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	path := "v2/content/" + strings.TrimPrefix(match[0], "v2/content/folders/")
+	if r.Method == http.MethodPut {
+		if object, ok := api.objects[path]; ok {
+			body["parentId"] = object["parentId"]
+		}
+	}
+	return fakeObjectHandler("")(api, r, body, []string{path})
+}
+
+func (api *fakeSumoAPI) newJob(status Status, result interface{}) (int, interface{}) {
+	id := fmt.Sprintf("JOB%v", api.newID(false))
+	api.jobs[id] = fakeJob{status: status, result: result}
+	return http.StatusOK, JobId{ID: id}
+}
+
+// handleContentImport stores the imported content. With overwrite, content of
+// the same name in the folder is replaced; without, it is an error.
+func (api *fakeSumoAPI) handleContentImport(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	if _, ok := api.objects["v2/content/"+match[1]]; !ok {
+		return http.StatusNotFound, "content:folder_not_found"
+	}
+	id := api.newID(false)
+	for path, object := range api.objects {
+		if object["parentId"] == match[1] && object["name"] == body["name"] {
+			if r.URL.Query().Get("overwrite") != "true" {
+				return api.newJob(Status{Status: "Failed", Error: Error{Code: "content:duplicate_content"}}, nil)
+			}
+			id = object["id"]
+			delete(api.objects, path)
+		}
+	}
+	body["id"] = id
+	body["parentId"] = match[1]
+	api.store(fmt.Sprintf("v2/content/%s", id), body)
+	return api.newJob(Status{Status: "Success", StatusMessage: fmt.Sprintf("id:%s", id)}, nil)
+}
+
+func (api *fakeSumoAPI) handleContentExport(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	object, ok := api.objects["v2/content/"+match[1]]
+	if !ok {
+		return http.StatusNotFound, "content:not_found"
+	}
+	// Exports describe the content itself, without its location.
+	exported := map[string]interface{}{}
+	for field, value := range object {
+		if field != "id" && field != "parentId" {
+			exported[field] = value
+		}
+	}
+	return api.newJob(Status{Status: "Success"}, exported)
+}
+
+func (api *fakeSumoAPI) handleContentDelete(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	path := "v2/content/" + match[1]
+	if _, ok := api.objects[path]; !ok {
+		return http.StatusNotFound, "content:not_found"
+	}
+	delete(api.objects, path)
+	return api.newJob(Status{Status: "Success"}, nil)
+}
+
+func (api *fakeSumoAPI) handleJobStatus(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	job, ok := api.jobs[match[1]]
+	if !ok {
+		return http.StatusNotFound, "api:job_not_found"
+	}
+	return http.StatusOK, job.status
+}
+
+func (api *fakeSumoAPI) handleJobResult(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	job, ok := api.jobs[match[1]]
+	if !ok || job.result == nil {
+		return http.StatusNotFound, "api:job_not_found"
+	}
+	return http.StatusOK, job.result
+}
+
+// handleCollectorUpgrade completes upgrades immediately, if the collector is
+// alive.
+func (api *fakeSumoAPI) handleCollectorUpgrade(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	path := fmt.Sprintf("v1/collectors/%v", body["collectorId"])
+	collector, ok := api.objects[path]
+	if !ok {
+		return http.StatusNotFound, "collectors.upgrade.collector.not.found"
+	}
+	if collector["alive"] != true {
+		return http.StatusBadRequest, "collectors.upgrade.collector.not.alive"
+	}
+	collector["collectorVersion"] = body["toVersion"]
+	api.versions[path]++
+
+	id := fmt.Sprint(api.newID(true))
+	api.jobs[id] = fakeJob{result: map[string]interface{}{
+		"upgrade": CollectorUpgrade{ID: id, ToVersion: fmt.Sprint(body["toVersion"]), Status: collectorUpgradeSucceeded},
+	}}
+	return http.StatusOK, CollectorUpgradeTaskResponse{ID: id}
+}
+
+func (api *fakeSumoAPI) handleCollectorUpgradeStatus(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	job, ok := api.jobs[match[1]]
+	if !ok {
+		return http.StatusNotFound, "collectors.upgrade.not.found"
+	}
+	return http.StatusOK, job.result
+}
+
+// handleSearchJobCreate supports the queries of monitor previews, which count
+// the messages by | timeslice. The job is done immediately.
+func (api *fakeSumoAPI) handleSearchJobCreate(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	timeslice := regexp.MustCompile(`\| timeslice (\w+)\s*\| count by _timeslice$`).FindStringSubmatch(fmt.Sprint(body["query"]))
+	if timeslice == nil {
+		return http.StatusBadRequest, "searchjob.unsupported.query"
+	}
+	slice, err := monitorTimeRangeDuration(timeslice[1])
+	from, fromErr := time.Parse("2006-01-02T15:04:05", fmt.Sprint(body["from"]))
+	to, toErr := time.Parse("2006-01-02T15:04:05", fmt.Sprint(body["to"]))
+	if err != nil || fromErr != nil || toErr != nil || body["timeZone"] != "UTC" {
+		return http.StatusBadRequest, "searchjob.invalid.timestamp"
+	}
+
+	counts := map[int64]int{}
+	for _, message := range api.messages {
+		if !message.Before(from) && message.Before(to) {
+			counts[message.Truncate(slice).UnixNano()/int64(time.Millisecond)]++
+		}
+	}
+	records := []interface{}{}
+	for timeslice, count := range counts {
+		records = append(records, map[string]interface{}{"map": map[string]string{
+			"_timeslice": strconv.FormatInt(timeslice, 10),
+			"_count":     strconv.Itoa(count),
+		}})
+	}
+
+	id := fmt.Sprint(api.newID(false))
+	api.jobs[id] = fakeJob{result: records}
+	return http.StatusAccepted, map[string]interface{}{"id": id}
+}
+
+func (api *fakeSumoAPI) handleSearchJob(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	job, ok := api.jobs[match[1]]
+	if !ok {
+		return http.StatusNotFound, "jobid.invalid"
+	}
+	if r.Method == http.MethodDelete {
+		delete(api.jobs, match[1])
+		return http.StatusOK, map[string]interface{}{"id": match[1]}
+	}
+	return http.StatusOK, SearchJobStatus{State: "DONE GATHERING RESULTS", RecordCount: len(job.result.([]interface{}))}
+}
+
+func (api *fakeSumoAPI) handleSearchJobRecords(r *http.Request, body map[string]interface{}, match []string) (int, interface{}) {
+	job, ok := api.jobs[match[1]]
+	if !ok {
+		return http.StatusNotFound, "jobid.invalid"
+	}
+	records := job.result.([]interface{})
+	if offset, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil && offset < len(records) {
+		records = records[offset:]
+	} else if err == nil {
+		records = records[:0]
+	}
+	if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && limit < len(records) {
+		records = records[:limit]
+	}
+	return http.StatusOK, map[string]interface{}{"records": records}
+}
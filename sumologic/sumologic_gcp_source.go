@@ -59,7 +59,7 @@ func (s *Client) CreateGCPSource(gcpSource GCPSource, collectorID int) (int, err
 
 func (s *Client) GetGCPSource(collectorID, sourceID int) (*GCPSource, error) {
 
-	body, _, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
+	body, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +79,8 @@ func (s *Client) GetGCPSource(collectorID, sourceID int) (*GCPSource, error) {
 		return nil, err
 	}
 
+	response.Source.ETag = etag
+
 	return &response.Source, nil
 }
 
@@ -93,7 +95,7 @@ func (s *Client) UpdateGCPSource(source GCPSource, collectorID int) error {
 	}
 
 	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, source.ID)
-	_, err := s.Put(urlPath, request, false)
+	_, err := s.PutWithETag(urlPath, request, source.ETag, false)
 
 	return err
 }
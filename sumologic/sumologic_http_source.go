@@ -8,7 +8,12 @@ import (
 type HTTPSource struct {
 	Source
 	MessagePerRequest bool   `json:"messagePerRequest"`
+	Paused            bool   `json:"paused"`
+	AuthMethod        string `json:"authMethod,omitempty"`
 	URL               string `json:"url,omitempty"`
+	// Token is only read, it is sent in the X-Sumo-Token header to sources
+	// using token authentication.
+	Token string `json:"token,omitempty"`
 }
 
 func (s *Client) CreateHTTPSource(httpSource HTTPSource, collectorID int) (int, error) {
@@ -40,7 +45,7 @@ func (s *Client) CreateHTTPSource(httpSource HTTPSource, collectorID int) (int,
 
 func (s *Client) GetHTTPSource(collectorID, sourceID int) (*HTTPSource, error) {
 
-	body, _, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
+	body, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +65,8 @@ func (s *Client) GetHTTPSource(collectorID, sourceID int) (*HTTPSource, error) {
 		return nil, err
 	}
 
+	response.Source.ETag = etag
+
 	return &response.Source, nil
 }
 
@@ -74,7 +81,16 @@ func (s *Client) UpdateHTTPSource(source HTTPSource, collectorID int) error {
 	}
 
 	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, source.ID)
-	_, err := s.Put(urlPath, request, false)
+	_, err := s.PutWithETag(urlPath, request, source.ETag, false)
+
+	return err
+}
+
+// RegenerateHTTPSourceURL replaces the URL, and token, of an HTTP source. Data
+// sent to the previous URL is rejected.
+func (s *Client) RegenerateHTTPSourceURL(collectorID, sourceID int) error {
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d/action/createNewUrl", collectorID, sourceID)
+	_, err := s.Post(urlPath, nil, false)
 
 	return err
 }
@@ -8,6 +8,7 @@ import (
 type KinesisMetricsSource struct {
 	Source
 	MessagePerRequest bool                 `json:"messagePerRequest"`
+	Paused            bool                 `json:"paused"`
 	URL               string               `json:"url,omitempty"`
 	ThirdPartyRef     PollingThirdPartyRef `json:"thirdPartyRef"`
 }
@@ -41,7 +42,7 @@ func (s *Client) CreateKinesisMetricsSource(kinesisMetricsSource KinesisMetricsS
 
 func (s *Client) GetKinesisMetricsSource(collectorID, sourceID int) (*KinesisMetricsSource, error) {
 
-	body, _, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
+	body, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +62,8 @@ func (s *Client) GetKinesisMetricsSource(collectorID, sourceID int) (*KinesisMet
 		return nil, err
 	}
 
+	response.Source.ETag = etag
+
 	return &response.Source, nil
 }
 
@@ -75,7 +78,7 @@ func (s *Client) UpdateKinesisMetricsSource(source KinesisMetricsSource, collect
 	}
 
 	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, source.ID)
-	_, err := s.Put(urlPath, request, false)
+	_, err := s.PutWithETag(urlPath, request, source.ETag, false)
 
 	return err
 }
@@ -0,0 +1,83 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type LocalFileSource struct {
+	Source
+	PathExpression string   `json:"pathExpression"`
+	Denylist       []string `json:"denylist,omitempty"`
+	Encoding       string   `json:"encoding,omitempty"`
+}
+
+func (s *Client) CreateLocalFileSource(localFileSource LocalFileSource, collectorID int) (int, error) {
+
+	type LocalFileSourceMessage struct {
+		Source LocalFileSource `json:"source"`
+	}
+
+	request := LocalFileSourceMessage{
+		Source: localFileSource,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources", collectorID)
+	body, err := s.Post(urlPath, request, false)
+
+	if err != nil {
+		return -1, err
+	}
+
+	var response LocalFileSourceMessage
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return -1, err
+	}
+
+	return response.Source.ID, nil
+}
+
+func (s *Client) GetLocalFileSource(collectorID, sourceID int) (*LocalFileSource, error) {
+
+	body, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if body == nil {
+		return nil, nil
+	}
+
+	type Response struct {
+		Source LocalFileSource `json:"source"`
+	}
+
+	var response Response
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Source.ETag = etag
+
+	return &response.Source, nil
+}
+
+func (s *Client) UpdateLocalFileSource(source LocalFileSource, collectorID int) error {
+
+	type LocalFileSourceMessage struct {
+		Source LocalFileSource `json:"source"`
+	}
+
+	request := LocalFileSourceMessage{
+		Source: source,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, source.ID)
+	_, err := s.PutWithETag(urlPath, request, source.ETag, false)
+
+	return err
+}
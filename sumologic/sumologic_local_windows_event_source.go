@@ -0,0 +1,86 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type LocalWindowsEventSource struct {
+	Source
+	LogNames       []string `json:"logNames"`
+	RenderMessages bool     `json:"renderMessages"`
+	EventFormat    int      `json:"eventFormat"`
+	EventMessage   int      `json:"eventMessage"`
+	Allowlist      string   `json:"allowlist,omitempty"`
+	Denylist       string   `json:"denylist,omitempty"`
+}
+
+func (s *Client) CreateLocalWindowsEventSource(localWindowsEventSource LocalWindowsEventSource, collectorID int) (int, error) {
+
+	type LocalWindowsEventSourceMessage struct {
+		Source LocalWindowsEventSource `json:"source"`
+	}
+
+	request := LocalWindowsEventSourceMessage{
+		Source: localWindowsEventSource,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources", collectorID)
+	body, err := s.Post(urlPath, request, false)
+
+	if err != nil {
+		return -1, err
+	}
+
+	var response LocalWindowsEventSourceMessage
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return -1, err
+	}
+
+	return response.Source.ID, nil
+}
+
+func (s *Client) GetLocalWindowsEventSource(collectorID, sourceID int) (*LocalWindowsEventSource, error) {
+
+	body, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if body == nil {
+		return nil, nil
+	}
+
+	type Response struct {
+		Source LocalWindowsEventSource `json:"source"`
+	}
+
+	var response Response
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Source.ETag = etag
+
+	return &response.Source, nil
+}
+
+func (s *Client) UpdateLocalWindowsEventSource(source LocalWindowsEventSource, collectorID int) error {
+
+	type LocalWindowsEventSourceMessage struct {
+		Source LocalWindowsEventSource `json:"source"`
+	}
+
+	request := LocalWindowsEventSourceMessage{
+		Source: source,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, source.ID)
+	_, err := s.PutWithETag(urlPath, request, source.ETag, false)
+
+	return err
+}
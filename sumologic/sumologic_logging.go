@@ -0,0 +1,224 @@
+package sumologic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
+)
+
+const redacted = "REDACTED"
+
+// sensitiveKeys lists JSON keys whose values never make it into the logs.
+// Keys are compared case-insensitively, ignoring '_' and '-'.
+var sensitiveKeys = map[string]bool{
+	"accesskey":             true,
+	"awskey":                true,
+	"secretkey":             true,
+	"secretaccesskey":       true,
+	"password":              true,
+	"clientsecret":          true,
+	"privatekey":            true,
+	"privatekeyid":          true,
+	"credentials":           true,
+	"encodedtokenandurl":    true,
+	"sharedaccesspolicykey": true,
+	"saskey":                true,
+	"apikey":                true,
+	"apitoken":              true,
+	"token":                 true,
+}
+
+// sourceSensitiveKeys lists the keys that are secret only in sources of a
+// type, e.g. the URL of an HTTP source, which accepts data without further
+// authentication.
+var sourceSensitiveKeys = map[string]map[string]bool{
+	"HTTP": {"url": true},
+}
+
+// headerListKeys are JSON keys holding lists of {name, value} HTTP headers, as
+// used by connections. The header values are redacted, the names kept.
+var headerListKeys = map[string]bool{
+	"headers":       true,
+	"customheaders": true,
+}
+
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// loggingTransport logs every API call: method, URL, status, latency and
+// request ID. When bodyLevel is set, the redacted headers and bodies are
+// logged too, at that level.
+type loggingTransport struct {
+	next      http.RoundTripper
+	bodyLevel string
+}
+
+// newLoggingTransport wraps next when TF_LOG is DEBUG or TRACE, or when
+// debugHTTP is set. Bodies are logged at TRACE, or at DEBUG with debugHTTP.
+func newLoggingTransport(next http.RoundTripper, debugHTTP bool) http.RoundTripper {
+	switch {
+	case debugHTTP:
+		return &loggingTransport{next: next, bodyLevel: "DEBUG"}
+	case logging.LogLevel() == "TRACE":
+		return &loggingTransport{next: next, bodyLevel: "TRACE"}
+	case logging.IsDebugOrHigher():
+		return &loggingTransport{next: next}
+	}
+	return next
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	log.Printf("[DEBUG] Sumo Logic API request: %s %s", req.Method, req.URL)
+	if t.bodyLevel != "" {
+		var body []byte
+		if req.GetBody != nil {
+			if rc, err := req.GetBody(); err == nil {
+				body, _ = ioutil.ReadAll(rc)
+				rc.Close()
+			}
+		}
+		log.Printf("[%s] Sumo Logic API request headers: %s", t.bodyLevel, redactHeaders(req.Header))
+		log.Printf("[%s] Sumo Logic API request body: %s", t.bodyLevel, redactBody(body))
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	latency := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		log.Printf("[DEBUG] Sumo Logic API request failed: %s %s after %s: %s", req.Method, req.URL, latency, err)
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return resp, err
+	}
+
+	log.Printf("[DEBUG] Sumo Logic API response: %s %s returned %d %s in %s (request id: %s)",
+		req.Method, req.URL, resp.StatusCode, http.StatusText(resp.StatusCode), latency, responseRequestID(resp, body))
+	if t.bodyLevel != "" {
+		log.Printf("[%s] Sumo Logic API response headers: %s", t.bodyLevel, redactHeaders(resp.Header))
+		log.Printf("[%s] Sumo Logic API response body: %s", t.bodyLevel, redactBody(body))
+	}
+
+	return resp, nil
+}
+
+func responseRequestID(resp *http.Response, body []byte) string {
+	if id := resp.Header.Get("X-Request-Id"); id != "" {
+		return id
+	}
+	if resp.StatusCode >= 400 {
+		var parsed apiErrorResponse
+		if json.Unmarshal(body, &parsed) == nil && parsed.ID != "" {
+			return parsed.ID
+		}
+	}
+	return "n/a"
+}
+
+func redactHeaders(header http.Header) string {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		value := strings.Join(header[name], ", ")
+		for _, sensitive := range sensitiveHeaders {
+			if http.CanonicalHeaderKey(name) == sensitive {
+				value = redacted
+			}
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", name, value))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// redactBody returns the JSON body with secrets replaced. Bodies that are not
+// JSON cannot be redacted reliably and are left out.
+func redactBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return "<empty>"
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return fmt.Sprintf("<%d bytes of non-JSON content omitted>", len(body))
+	}
+
+	out, err := json.Marshal(redactValue(parsed))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes omitted>", len(body))
+	}
+	return string(out)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		sourceType, _ := value["sourceType"].(string)
+		for k, field := range value {
+			key := normalizeLogKey(k)
+			switch {
+			case (sensitiveKeys[key] || sourceSensitiveKeys[sourceType][key]) && field != nil:
+				value[k] = redacted
+			case headerListKeys[key]:
+				value[k] = redactHeaderList(field)
+			default:
+				value[k] = redactValue(field)
+			}
+		}
+		return value
+	case []interface{}:
+		for i := range value {
+			value[i] = redactValue(value[i])
+		}
+		return value
+	case string:
+		// Cloud-to-Cloud and content payloads sometimes embed JSON documents
+		// as strings.
+		trimmed := strings.TrimSpace(value)
+		if strings.HasPrefix(trimmed, "{") {
+			var nested interface{}
+			if json.Unmarshal([]byte(trimmed), &nested) == nil {
+				out, _ := json.Marshal(redactValue(nested))
+				return string(out)
+			}
+		}
+		return value
+	}
+	return v
+}
+
+func redactHeaderList(v interface{}) interface{} {
+	headers, ok := v.([]interface{})
+	if !ok {
+		return redactValue(v)
+	}
+	for _, h := range headers {
+		if header, ok := h.(map[string]interface{}); ok {
+			if _, ok := header["value"]; ok {
+				header["value"] = redacted
+			}
+		}
+	}
+	return headers
+}
+
+func normalizeLogKey(key string) string {
+	key = strings.ToLower(key)
+	key = strings.Replace(key, "_", "", -1)
+	return strings.Replace(key, "-", "", -1)
+}
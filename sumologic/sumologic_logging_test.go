@@ -0,0 +1,112 @@
+package sumologic
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func captureLog(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+	return &buf
+}
+
+func TestLoggingTransportRedactsSecrets(t *testing.T) {
+	buf := captureLog(t)
+
+	transport := &loggingTransport{
+		next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			resp := newTestResponse(201, `{"id":"0001","config":{"awsKey":"AKIASECRET","encodedTokenAndUrl":"c2VjcmV0"}}`,
+				http.Header{"X-Request-Id": []string{"REQ123"}, "Set-Cookie": []string{"session=secret"}})
+			resp.Request = req
+			return resp, nil
+		}),
+		bodyLevel: "TRACE",
+	}
+
+	body := `{"accessKey":"topsecret","authentication":{"type":"service_account","private_key":"-----BEGIN KEY-----"},` +
+		`"headers":[{"name":"X-Api-Key","value":"headersecret"}],"name":"visible"}`
+	req, _ := http.NewRequest("POST", "https://api.sumologic.com/api/v1/collectors", strings.NewReader(body))
+	req.Header.Set("Authorization", "Basic c2VjcmV0")
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Expected RoundTrip to succeed, received: %s", err)
+	}
+	respBody, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(respBody), "AKIASECRET") {
+		t.Error("Expected the response body to be readable after logging")
+	}
+
+	out := buf.String()
+	for _, secret := range []string{"topsecret", "BEGIN KEY", "headersecret", "c2VjcmV0", "AKIASECRET", "session=secret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Expected %q to be redacted, log was:\n%s", secret, out)
+		}
+	}
+	for _, expected := range []string{"POST https://api.sumologic.com/api/v1/collectors", "201", "request id: REQ123", `"name":"visible"`, "X-Api-Key"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("Expected the log to contain %q, log was:\n%s", expected, out)
+		}
+	}
+}
+
+func TestRedactBodyHidesHTTPSourceURLAndToken(t *testing.T) {
+	body := `{"source":{"sourceType":"HTTP","name":"logs","url":"https://collectors.sumologic.com/receiver/v1/http/c2VjcmV0",` +
+		`"token":"dG9rZW4="},"connection":{"url":"https://hooks.example.com"}}`
+	out := redactBody([]byte(body))
+	for _, secret := range []string{"c2VjcmV0", "dG9rZW4="} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Expected %q to be redacted, got %s", secret, out)
+		}
+	}
+	for _, expected := range []string{`"name":"logs"`, "https://hooks.example.com"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("Expected %q to be kept, got %s", expected, out)
+		}
+	}
+}
+
+func TestLoggingTransportOmitsBodiesByDefault(t *testing.T) {
+	buf := captureLog(t)
+
+	transport := &loggingTransport{
+		next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return newTestResponse(404, `{"id":"ERR42","errors":[{"code":"collector:not_found"}]}`, nil), nil
+		}),
+	}
+	req, _ := http.NewRequest("GET", "https://api.sumologic.com/api/v1/collectors/1", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("Expected RoundTrip to succeed, received: %s", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "request id: ERR42") {
+		t.Errorf("Expected the request id from the error body, log was:\n%s", out)
+	}
+	if strings.Contains(out, "collector:not_found") {
+		t.Errorf("Expected no body to be logged, log was:\n%s", out)
+	}
+}
+
+func TestRedactBodyOmitsNonJSON(t *testing.T) {
+	if got := redactBody([]byte("accessKey=secret")); strings.Contains(got, "secret") {
+		t.Errorf("Expected non-JSON content to be omitted, got %q", got)
+	}
+	got := redactBody([]byte(`{"config":"{\"password\":\"secret\"}"}`))
+	if strings.Contains(got, "secret") {
+		t.Errorf("Expected nested JSON strings to be redacted, got %q", got)
+	}
+}
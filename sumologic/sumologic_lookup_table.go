@@ -32,7 +32,6 @@ func (s *Client) CreateLookupTable(lookupTable LookupTable) (string, error) {
 		return "", err
 	}
 
-	log.Printf("##DEBUG## created lookuptable: %+v\n\n", createdLookupTable)
 	return createdLookupTable.ID, nil
 
 }
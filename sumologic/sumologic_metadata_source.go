@@ -68,7 +68,7 @@ func (s *Client) CreateMetadataSource(source MetadataSource, collectorID int) (i
 
 func (s *Client) GetMetadataSource(collectorID, sourceID int) (*MetadataSource, error) {
 	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID)
-	body, _, err := s.Get(urlPath, false)
+	body, etag, err := s.Get(urlPath, false)
 
 	if err != nil {
 		return nil, err
@@ -89,6 +89,8 @@ func (s *Client) GetMetadataSource(collectorID, sourceID int) (*MetadataSource,
 		return nil, err
 	}
 
+	response.Source.ETag = etag
+
 	return &response.Source, nil
 }
 
@@ -103,7 +105,7 @@ func (s *Client) UpdateMetadataSource(source MetadataSource, collectorID int) er
 		Source: source,
 	}
 
-	_, err := s.Put(url, request, false)
+	_, err := s.PutWithETag(url, request, source.ETag, false)
 
 	return err
 }
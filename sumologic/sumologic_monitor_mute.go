@@ -0,0 +1,81 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ---------- ENDPOINTS ----------
+
+func (s *Client) CreateMonitorMute(mute MonitorMute) (string, error) {
+	data, err := s.Post("v1/mutingSchedules", mute, false)
+	if err != nil {
+		return "", err
+	}
+
+	var createdMute MonitorMute
+	if err := json.Unmarshal(data, &createdMute); err != nil {
+		return "", err
+	}
+	return createdMute.ID, nil
+}
+
+func (s *Client) GetMonitorMute(id string) (*MonitorMute, error) {
+	data, _, err := s.Get(fmt.Sprintf("v1/mutingSchedules/%s", id), false)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var mute MonitorMute
+	if err := json.Unmarshal(data, &mute); err != nil {
+		return nil, err
+	}
+	return &mute, nil
+}
+
+func (s *Client) UpdateMonitorMute(mute MonitorMute) error {
+	urlPath := fmt.Sprintf("v1/mutingSchedules/%s", mute.ID)
+	mute.ID = ""
+
+	_, err := s.Put(urlPath, mute, false)
+	return err
+}
+
+func (s *Client) DeleteMonitorMute(id string) error {
+	_, err := s.Delete(fmt.Sprintf("v1/mutingSchedules/%s", id))
+	return err
+}
+
+// ---------- TYPES ----------
+
+// MonitorMute is a muting schedule, which silences the notifications of
+// monitors during its windows.
+type MonitorMute struct {
+	ID          string              `json:"id,omitempty"`
+	Type        string              `json:"type"`
+	ContentType string              `json:"contentType"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Monitor     MonitorMuteScope    `json:"monitor"`
+	Schedule    MonitorMuteSchedule `json:"schedule"`
+}
+
+// MonitorMuteScope holds the IDs of the monitors and monitor folders muted.
+type MonitorMuteScope struct {
+	IDs []string `json:"ids"`
+	All bool     `json:"all"`
+}
+
+type MonitorMuteSchedule struct {
+	TimeZone  string `json:"timezone"`
+	StartDate string `json:"startDate"`
+	StartTime string `json:"startTime"`
+	// Duration is the length of each window in minutes.
+	Duration int    `json:"duration"`
+	RRule    string `json:"rrule,omitempty"`
+}
+
+// ---------- END ----------
@@ -3,7 +3,6 @@ package sumologic
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 )
 
 // ---------- ENDPOINTS ----------
@@ -26,7 +25,6 @@ func (s *Client) CreateMonitorsLibraryFolder(monitorsLibraryFolder MonitorsLibra
 	if err != nil {
 		return "", err
 	}
-	log.Printf("created monitor response: %v", data)
 
 	var createdMonitorsLibraryFolder MonitorsLibraryFolder
 
@@ -3,7 +3,6 @@ package sumologic
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 )
 
 // ---------- ENDPOINTS ----------
@@ -26,7 +25,6 @@ func (s *Client) CreateMonitorsLibraryMonitor(monitorsLibraryMonitor MonitorsLib
 	if err != nil {
 		return "", err
 	}
-	log.Printf("created monitor response: %v", data)
 
 	var createdMonitorsLibraryMonitor MonitorsLibraryMonitor
 
@@ -136,6 +134,7 @@ type MonitorsLibraryMonitor struct {
 	IsDisabled         bool                  `json:"isDisabled"`
 	Status             []string              `json:"status"`
 	GroupNotifications bool                  `json:"groupNotifications"`
+	SloID              string                `json:"sloId,omitempty"`
 }
 
 type MonitorQuery struct {
@@ -144,13 +143,31 @@ type MonitorQuery struct {
 }
 
 type TriggerCondition struct {
-	TimeRange       string  `json:"timeRange"`
-	TriggerType     string  `json:"triggerType"`
-	Threshold       float64 `json:"threshold,omitempty"`
-	ThresholdType   string  `json:"thresholdType,omitempty"`
-	OccurrenceType  string  `json:"occurrenceType"`
-	TriggerSource   string  `json:"triggerSource"`
-	DetectionMethod string  `json:"detectionMethod"`
+	TimeRange       string   `json:"timeRange,omitempty"`
+	TriggerType     string   `json:"triggerType"`
+	Threshold       *float64 `json:"threshold,omitempty"`
+	ThresholdType   string   `json:"thresholdType,omitempty"`
+	OccurrenceType  string   `json:"occurrenceType,omitempty"`
+	TriggerSource   string   `json:"triggerSource,omitempty"`
+	DetectionMethod string   `json:"detectionMethod"`
+	Field           string   `json:"field,omitempty"`
+	Window          int      `json:"window,omitempty"`
+	Consecutive     int      `json:"consecutive,omitempty"`
+	BaselineWindow  string   `json:"baselineWindow,omitempty"`
+	Direction       string   `json:"direction,omitempty"`
+	// BurnRateThreshold is the rate at which the error budget of an SLO is
+	// consumed, relative to the rate that consumes it exactly.
+	BurnRateThreshold float64 `json:"burnRateThreshold,omitempty"`
+}
+
+// threshold returns the threshold of the trigger, or 0 if it has none. The
+// Threshold is a pointer so that thresholds of 0 are sent, while triggers
+// without one, e.g. missing data triggers, omit it.
+func (t TriggerCondition) threshold() float64 {
+	if t.Threshold == nil {
+		return 0
+	}
+	return *t.Threshold
 }
 
 type MonitorNotification struct {
@@ -3,23 +3,18 @@ package sumologic
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 func (s *Client) GetPartition(id string) (*Partition, error) {
 	data, _, err := s.Get(fmt.Sprintf("v1/partitions/%s", id), false)
-	if err != nil {
-		if strings.Contains(err.Error(), "Partition Not Found") {
-			if data == nil {
-				return nil, nil
-			} else {
-				return nil, err
-			}
-		}
-	} else {
-		if data == nil {
-			return nil, nil
-		}
+	if IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, nil
 	}
 
 	var spartition Partition
@@ -35,9 +35,19 @@ type PollingPath struct {
 	Type              string      `json:"type"`
 	BucketName        string      `json:"bucketName,omitempty"`
 	PathExpression    string      `json:"pathExpression,omitempty"`
+	UseVersionedApi   *bool       `json:"useVersionedApi,omitempty"`
 	LimitToRegions    []string    `json:"limitToRegions,omitempty"`
 	LimitToNamespaces []string    `json:"limitToNamespaces,omitempty"`
+	LimitToServices   []string    `json:"limitToServices,omitempty"`
 	TagFilters        []TagFilter `json:"tagFilters,omitempty"`
+	// SnsTopicOrSubscriptionArn is only read, it is set up by subscribing the
+	// source's URL to an SNS topic.
+	SnsTopicOrSubscriptionArn *PollingSnsTopicOrSubscriptionArn `json:"snsTopicOrSubscriptionArn,omitempty"`
+}
+
+type PollingSnsTopicOrSubscriptionArn struct {
+	IsSuccess bool   `json:"isSuccess"`
+	Arn       string `json:"arn"`
 }
 
 type TagFilter struct {
@@ -76,7 +86,7 @@ func (s *Client) CreatePollingSource(source PollingSource, collectorID int) (int
 
 func (s *Client) GetPollingSource(collectorID, sourceID int) (*PollingSource, error) {
 	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID)
-	body, _, err := s.Get(urlPath, false)
+	body, etag, err := s.Get(urlPath, false)
 
 	if err != nil {
 		return nil, err
@@ -97,6 +107,8 @@ func (s *Client) GetPollingSource(collectorID, sourceID int) (*PollingSource, er
 		return nil, err
 	}
 
+	response.Source.ETag = etag
+
 	return &response.Source, nil
 }
 
@@ -111,7 +123,7 @@ func (s *Client) UpdatePollingSource(source PollingSource, collectorID int) erro
 		Source: source,
 	}
 
-	_, err := s.Put(url, request, false)
+	_, err := s.PutWithETag(url, request, source.ETag, false)
 
 	return err
 }
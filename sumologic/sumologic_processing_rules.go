@@ -0,0 +1,360 @@
+package sumologic
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Processing rules, the filters of a source, use Java regular expressions,
+// while Go implements RE2. The common subset is validated and evaluated
+// locally; expressions using a Java construct that RE2 lacks, e.g. a
+// lookahead, are left to the API.
+
+// javaRegexp is a Java regular expression translated to RE2 syntax.
+type javaRegexp struct {
+	// pattern is the RE2 translation, only valid if unsupported is empty.
+	pattern string
+	// groups is the number of capturing groups.
+	groups int
+	// unsupported names the first Java construct found that RE2 lacks.
+	unsupported string
+}
+
+// javaPosixClasses maps the POSIX classes of Java, e.g. \p{Alpha}, to RE2.
+var javaPosixClasses = map[string]string{
+	"Lower":  "[:lower:]",
+	"Upper":  "[:upper:]",
+	"ASCII":  "[:ascii:]",
+	"Alpha":  "[:alpha:]",
+	"Digit":  "[:digit:]",
+	"Alnum":  "[:alnum:]",
+	"Punct":  "[:punct:]",
+	"Graph":  "[:graph:]",
+	"Print":  "[:print:]",
+	"Blank":  "[:blank:]",
+	"Cntrl":  "[:cntrl:]",
+	"XDigit": "[:xdigit:]",
+	"Space":  "[:space:]",
+}
+
+func translateJavaRegexp(expr string) javaRegexp {
+	var result javaRegexp
+	var out strings.Builder
+	inClass := false
+	unsupported := func(construct string) javaRegexp {
+		result.unsupported = construct
+		return result
+	}
+
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == '\\' && i+1 < len(expr):
+			next := expr[i+1]
+			switch {
+			case next >= '1' && next <= '9', next == 'k':
+				return unsupported("a backreference")
+			case next == 'Z', next == 'G', next == 'R', next == 'X', next == 'h', next == 'H', next == 'V':
+				return unsupported(`\` + string(next))
+			case next == 'Q':
+				end := strings.Index(expr[i+2:], `\E`)
+				if end < 0 {
+					out.WriteString(expr[i:])
+					i = len(expr)
+					continue
+				}
+				out.WriteString(expr[i : i+2+end+2])
+				i += 2 + end + 2
+				continue
+			case (next == 'p' || next == 'P') && strings.HasPrefix(expr[i+2:], "{"):
+				end := strings.Index(expr[i+2:], "}")
+				if end < 0 {
+					break
+				}
+				name := expr[i+3 : i+2+end]
+				if class, ok := javaPosixClasses[strings.TrimPrefix(name, "Is")]; ok {
+					if next == 'P' {
+						class = "[:^" + class[2:]
+					}
+					if inClass {
+						out.WriteString(class)
+					} else {
+						out.WriteString("[" + class + "]")
+					}
+					i += 2 + end + 1
+					continue
+				}
+				if strings.HasPrefix(name, "java") || strings.HasPrefix(name, "In") || strings.HasPrefix(name, "Is") {
+					return unsupported(`\p{` + name + `}`)
+				}
+				// A Unicode class, e.g. \p{L}, is the same in RE2.
+				out.WriteString(expr[i : i+2+end+1])
+				i += 2 + end + 1
+				continue
+			case next == 'e':
+				out.WriteString(`\x1b`)
+				i += 2
+				continue
+			case next == 'c' && i+2 < len(expr) && expr[i+2] < utf8.RuneSelf:
+				// \cX is the control character of X.
+				fmt.Fprintf(&out, `\x{%02x}`, expr[i+2]^0x40)
+				i += 3
+				continue
+			case next == 'u' && isHex(expr[i+2:], 4):
+				fmt.Fprintf(&out, `\x{%s}`, expr[i+2:i+6])
+				i += 6
+				continue
+			}
+			out.WriteString(expr[i : i+2])
+			i += 2
+			continue
+		case inClass:
+			if c == '[' {
+				return unsupported("a nested character class")
+			}
+			if c == '&' && strings.HasPrefix(expr[i:], "&&") {
+				return unsupported("a character class intersection")
+			}
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+			out.WriteByte(c)
+			i++
+			// A ] right after [ or [^ is a literal.
+			if strings.HasPrefix(expr[i:], "^") {
+				out.WriteByte('^')
+				i++
+			}
+			if strings.HasPrefix(expr[i:], "]") {
+				out.WriteByte(']')
+				i++
+			}
+			continue
+		case c == '(' && strings.HasPrefix(expr[i:], "(?"):
+			rest := expr[i+2:]
+			switch {
+			case strings.HasPrefix(rest, "="), strings.HasPrefix(rest, "!"):
+				return unsupported("a lookahead")
+			case strings.HasPrefix(rest, "<="), strings.HasPrefix(rest, "<!"):
+				return unsupported("a lookbehind")
+			case strings.HasPrefix(rest, ">"):
+				return unsupported("an atomic group")
+			case strings.HasPrefix(rest, "<"):
+				result.groups++
+				out.WriteString("(?P<")
+				i += 3
+				continue
+			case strings.HasPrefix(rest, ":"):
+			default:
+				// Flags, e.g. (?i) or (?s:...): RE2 knows i, m and s; U
+				// means something else in Java.
+				for _, flag := range rest {
+					if flag == ')' || flag == ':' {
+						break
+					}
+					if !strings.ContainsRune("ims-", flag) {
+						return unsupported(fmt.Sprintf("the %q flag", flag))
+					}
+				}
+			}
+		case c == '(':
+			result.groups++
+		case c == '*' || c == '+' || c == '?' || c == '}':
+			if strings.HasPrefix(expr[i+1:], "+") {
+				return unsupported("a possessive quantifier")
+			}
+		}
+		_, size := utf8.DecodeRuneInString(expr[i:])
+		out.WriteString(expr[i : i+size])
+		i += size
+	}
+
+	result.pattern = out.String()
+	return result
+}
+
+// isHex returns whether s starts with n hexadecimal digits.
+func isHex(s string, n int) bool {
+	if len(s) < n {
+		return false
+	}
+	for _, c := range s[:n] {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// compileFilterRegexp compiles the regexp of a filter so that it has to match
+// whole log lines, like Sumo Logic does.
+func compileFilterRegexp(expr string) (*regexp.Regexp, javaRegexp, error) {
+	translated := translateJavaRegexp(expr)
+	if translated.unsupported != "" {
+		return nil, translated, nil
+	}
+	re, err := regexp.Compile("^(?:" + translated.pattern + ")$")
+	if err != nil {
+		// Report the error for the expression as written.
+		if _, plainErr := regexp.Compile(translated.pattern); plainErr != nil {
+			err = plainErr
+		}
+	}
+	return re, translated, err
+}
+
+// validateFilterRegexp is the ValidateFunc of the regexp of a filter.
+func validateFilterRegexp(i interface{}, k string) ([]string, []error) {
+	expr, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	_, translated, err := compileFilterRegexp(expr)
+	if translated.unsupported != "" {
+		return []string{fmt.Sprintf("%s: %q uses %s, which cannot be validated before it is sent to Sumo Logic",
+			k, expr, translated.unsupported)}, nil
+	}
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s: %q is not a valid regular expression: %s", k, expr, err)}
+	}
+	return nil, nil
+}
+
+// checkFilter checks the settings of a filter that depend on each other.
+func checkFilter(filter Filter) error {
+	switch filter.FilterType {
+	case "Mask", "Hash":
+		// Groups cannot be counted reliably past a construct RE2 lacks.
+		translated := translateJavaRegexp(filter.Regexp)
+		if translated.unsupported == "" && translated.groups == 0 {
+			return fmt.Errorf("filter %q: %s filters must contain a capturing group, e.g. (\\d+), around the part to %s",
+				filter.Name, filter.FilterType, strings.ToLower(filter.FilterType))
+		}
+		if filter.FilterType == "Mask" && filter.Mask == "" {
+			return fmt.Errorf("filter %q: mask must be set for Mask filters", filter.Name)
+		}
+	}
+	return nil
+}
+
+// resourceSumologicSourceCustomizeDiff checks the filters of a source at plan
+// time.
+func resourceSumologicSourceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	for i, raw := range d.Get("filters").([]interface{}) {
+		config := raw.(map[string]interface{})
+		if !d.NewValueKnown(fmt.Sprintf("filters.%d.regexp", i)) || !d.NewValueKnown(fmt.Sprintf("filters.%d.mask", i)) {
+			continue
+		}
+		filter := Filter{
+			Name:       config["name"].(string),
+			FilterType: config["filter_type"].(string),
+			Regexp:     config["regexp"].(string),
+			Mask:       config["mask"].(string),
+		}
+		if err := checkFilter(filter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// filterResult is the outcome of processing a log line with filters.
+type filterResult struct {
+	Line      string
+	Kept      bool
+	Forwarded bool
+	// Output is the line after masking and hashing.
+	Output         string
+	MatchedFilters []string
+}
+
+// evaluateFilters processes log lines like Sumo Logic does: a line is dropped
+// if it matches an Exclude filter or if there are Include filters and it
+// matches none of them, kept lines are masked and hashed, and forwarded if
+// they match a Forward filter.
+func evaluateFilters(filters []Filter, lines []string) ([]filterResult, error) {
+	compiled := make([]*regexp.Regexp, len(filters))
+	hasInclude := false
+	for i, filter := range filters {
+		if err := checkFilter(filter); err != nil {
+			return nil, err
+		}
+		re, translated, err := compileFilterRegexp(filter.Regexp)
+		if translated.unsupported != "" {
+			return nil, fmt.Errorf("filter %q uses %s, which cannot be evaluated locally", filter.Name, translated.unsupported)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("filter %q: %q is not a valid regular expression: %s", filter.Name, filter.Regexp, err)
+		}
+		compiled[i] = re
+		hasInclude = hasInclude || filter.FilterType == "Include"
+	}
+
+	results := make([]filterResult, 0, len(lines))
+	for _, line := range lines {
+		result := filterResult{Line: line, Output: line, MatchedFilters: []string{}}
+		included, excluded := !hasInclude, false
+		for i, filter := range filters {
+			if !compiled[i].MatchString(line) {
+				continue
+			}
+			result.MatchedFilters = append(result.MatchedFilters, filter.Name)
+			switch filter.FilterType {
+			case "Include":
+				included = true
+			case "Exclude":
+				excluded = true
+			}
+		}
+		result.Kept = included && !excluded
+		if result.Kept {
+			for i, filter := range filters {
+				switch filter.FilterType {
+				case "Mask", "Hash":
+					result.Output = replaceGroups(compiled[i], result.Output, filter)
+				case "Forward":
+					result.Forwarded = result.Forwarded || compiled[i].MatchString(line)
+				}
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// replaceGroups replaces the capturing groups of a Mask or Hash filter in
+// line. Sumo Logic's hashes are not public; an MD5 hash stands in for them.
+func replaceGroups(re *regexp.Regexp, line string, filter Filter) string {
+	match := re.FindStringSubmatchIndex(line)
+	if match == nil {
+		return line
+	}
+	// Replace the outermost groups, from the last one on so that the earlier
+	// offsets still hold.
+	var spans [][2]int
+	for group := 1; group < len(match)/2; group++ {
+		start, end := match[2*group], match[2*group+1]
+		if start < 0 || len(spans) > 0 && start < spans[len(spans)-1][1] {
+			continue
+		}
+		spans = append(spans, [2]int{start, end})
+	}
+	for i := len(spans) - 1; i >= 0; i-- {
+		start, end := spans[i][0], spans[i][1]
+		replacement := filter.Mask
+		if filter.FilterType == "Hash" {
+			sum := md5.Sum([]byte(line[start:end]))
+			replacement = hex.EncodeToString(sum[:])
+		}
+		line = line[:start] + replacement + line[end:]
+	}
+	return line
+}
@@ -0,0 +1,170 @@
+package sumologic
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestTranslateJavaRegexp(t *testing.T) {
+	for _, tc := range []struct {
+		expr        string
+		pattern     string
+		groups      int
+		unsupported string
+	}{
+		{expr: `.*user=(\w+).*`, pattern: `.*user=(\w+).*`, groups: 1},
+		{expr: `(?<user>\w+) (?:logged in|out)`, pattern: `(?P<user>\w+) (?:logged in|out)`, groups: 1},
+		{expr: `\p{Alpha}+[\p{Digit}_]*\P{Space}`, pattern: `[[:alpha:]]+[[:digit:]_]*[[:^space:]]`},
+		{expr: `[(]\(not a group\)[]a]`, pattern: `[(]\(not a group\)[]a]`},
+		{expr: `\Q(literal)\E(x)`, pattern: `\Q(literal)\E(x)`, groups: 1},
+		{expr: `(?i)(?s:.*error.*)`, pattern: `(?i)(?s:.*error.*)`},
+		{expr: `\p{L}+ \p{Lu}*`, pattern: `\p{L}+ \p{Lu}*`},
+		{expr: `\e\[0m.*\cM`, pattern: `\x1b\[0m.*\x{0d}`},
+		{expr: `caf\u00e9 [\u0041-\u005A]`, pattern: `caf\x{00e9} [\x{0041}-\x{005A}]`},
+		{expr: `.*(?=foo).*`, unsupported: "a lookahead"},
+		{expr: `.*(?<!foo)bar`, unsupported: "a lookbehind"},
+		{expr: `(?>a+)b`, unsupported: "an atomic group"},
+		{expr: `(a)\1`, unsupported: "a backreference"},
+		{expr: `a*+b`, unsupported: "a possessive quantifier"},
+		{expr: `[a-z&&[^aeiou]]`, unsupported: "a character class intersection"},
+		{expr: `[a-z[0-9]]`, unsupported: "a nested character class"},
+		{expr: `(?x) a b`, unsupported: `the 'x' flag`},
+		{expr: `\p{javaLowerCase}`, unsupported: `\p{javaLowerCase}`},
+	} {
+		translated := translateJavaRegexp(tc.expr)
+		if translated.unsupported != tc.unsupported {
+			t.Errorf("%s: unsupported = %q, want %q", tc.expr, translated.unsupported, tc.unsupported)
+			continue
+		}
+		if tc.unsupported != "" {
+			continue
+		}
+		if translated.pattern != tc.pattern || translated.groups != tc.groups {
+			t.Errorf("%s: got %q with %d groups, want %q with %d groups",
+				tc.expr, translated.pattern, translated.groups, tc.pattern, tc.groups)
+		}
+	}
+}
+
+func TestValidateFilterRegexp(t *testing.T) {
+	if warnings, errs := validateFilterRegexp(`.*(\d{3})-\d{4}.*`, "regexp"); len(warnings) > 0 || len(errs) > 0 {
+		t.Errorf("valid expression: warnings %v, errors %v", warnings, errs)
+	}
+	if _, errs := validateFilterRegexp(`.*(unclosed.*`, "regexp"); len(errs) != 1 || !strings.Contains(errs[0].Error(), "missing closing )") {
+		t.Errorf("invalid expression: errors %v", errs)
+	}
+	for _, expr := range []string{`\e\[0m.*`, `.*\cM`, `.*\u00e9.*`, `\p{L}+`} {
+		if warnings, errs := validateFilterRegexp(expr, "regexp"); len(warnings) > 0 || len(errs) > 0 {
+			t.Errorf("%s: warnings %v, errors %v", expr, warnings, errs)
+		}
+	}
+	if warnings, errs := validateFilterRegexp(`.*(?=secret).*`, "regexp"); len(warnings) != 1 || len(errs) > 0 {
+		t.Errorf("Java only expression: warnings %v, errors %v", warnings, errs)
+	}
+}
+
+func TestCheckFilter(t *testing.T) {
+	for _, tc := range []struct {
+		filter Filter
+		err    string
+	}{
+		{filter: Filter{Name: "drop", FilterType: "Exclude", Regexp: ".*DEBUG.*"}},
+		{filter: Filter{Name: "mask", FilterType: "Mask", Regexp: ".*password=(\\S+).*", Mask: "***"}},
+		{filter: Filter{Name: "mask", FilterType: "Mask", Regexp: ".*password=\\S+.*", Mask: "***"}, err: "must contain a capturing group"},
+		{filter: Filter{Name: "mask", FilterType: "Mask", Regexp: ".*password=(?:\\S+).*", Mask: "***"}, err: "must contain a capturing group"},
+		{filter: Filter{Name: "mask", FilterType: "Mask", Regexp: ".*password=(\\S+).*"}, err: "mask must be set"},
+		{filter: Filter{Name: "hash", FilterType: "Hash", Regexp: ".*user=\\w+.*"}, err: "must contain a capturing group"},
+		{filter: Filter{Name: "hash", FilterType: "Hash", Regexp: ".*user=(?<user>\\w+).*"}},
+		{filter: Filter{Name: "hash", FilterType: "Hash", Regexp: ".*user=\\p{L}+.*"}, err: "must contain a capturing group"},
+	} {
+		err := checkFilter(tc.filter)
+		if tc.err == "" && err != nil || tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("%+v: got error %v, want %q", tc.filter, err, tc.err)
+		}
+	}
+}
+
+func TestEvaluateFilters(t *testing.T) {
+	filters := []Filter{
+		{Name: "app only", FilterType: "Include", Regexp: ".*app=.*"},
+		{Name: "no debug", FilterType: "Exclude", Regexp: ".*DEBUG.*"},
+		{Name: "card", FilterType: "Mask", Regexp: `.*card=(\d{12})(\d{4}).*`, Mask: "#"},
+		{Name: "user", FilterType: "Hash", Regexp: `.*user=(\w+).*`},
+		{Name: "errors", FilterType: "Forward", Regexp: ".*ERROR.*"},
+	}
+	results, err := evaluateFilters(filters, []string{
+		"INFO app=web user=alice",
+		"DEBUG app=web verbose",
+		"ERROR app=shop card=4111111111111111",
+		"INFO other",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []filterResult{
+		{Line: "INFO app=web user=alice", Kept: true, Output: "INFO app=web user=6384e2b2184bcbf58eccf10ca7a6563c",
+			MatchedFilters: []string{"app only", "user"}},
+		{Line: "DEBUG app=web verbose", Output: "DEBUG app=web verbose",
+			MatchedFilters: []string{"app only", "no debug"}},
+		{Line: "ERROR app=shop card=4111111111111111", Kept: true, Forwarded: true, Output: "ERROR app=shop card=##",
+			MatchedFilters: []string{"app only", "card", "errors"}},
+		{Line: "INFO other", Output: "INFO other", MatchedFilters: []string{}},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("got %+v, want %+v", results, want)
+	}
+
+	_, err = evaluateFilters([]Filter{{Name: "java", FilterType: "Exclude", Regexp: ".*(?=x).*"}}, []string{"x"})
+	if err == nil || !strings.Contains(err.Error(), "cannot be evaluated locally") {
+		t.Errorf("Java only expression: got error %v", err)
+	}
+}
+
+func TestUnitSumologicSourceFilters(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	config := func(filterType, regexp, mask string) string {
+		return fmt.Sprintf(`
+resource "sumologic_collector" "test" {
+	name = "collector"
+}
+
+resource "sumologic_http_source" "test" {
+	name = "http"
+	collector_id = sumologic_collector.test.id
+	filters {
+		name = "filter"
+		filter_type = "%s"
+		regexp = "%s"
+		mask = "%s"
+	}
+}`, filterType, regexp, mask)
+	}
+
+	api.unitTest(t,
+		resource.TestStep{
+			Config:      config("Exclude", ".*[debug.*", ""),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("is not a valid regular expression: error parsing regexp: missing closing ]"),
+		},
+		resource.TestStep{
+			Config:      config("Mask", ".*password=\\\\S+.*", "***"),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("Mask filters must contain a capturing group"),
+		},
+		resource.TestStep{
+			Config:      config("Mask", ".*password=(\\\\S+).*", ""),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("mask must be set for Mask filters"),
+		},
+		resource.TestStep{
+			Config: config("Hash", ".*user=(?<user>\\\\w+).*", ""),
+			Check:  resource.TestCheckResourceAttr("sumologic_http_source.test", "filters.0.regexp", ".*user=(?<user>\\w+).*"),
+		},
+	)
+}
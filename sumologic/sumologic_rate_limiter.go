@@ -0,0 +1,123 @@
+package sumologic
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRequestsPerMinute = 240
+	defaultBurst             = 10
+
+	// The limiter never slows down below this fraction of the configured rate,
+	// however many 429s it sees.
+	minRateFraction = 1.0 / 8
+	// Fraction of the configured rate recovered after every successful call.
+	rateRecoveryFraction = 1.0 / 20
+)
+
+// rateLimiter is a token bucket owned by a single Client. Tokens refill at the
+// current rate up to burst; a request consumes one token and waits when none
+// is left. The current rate halves whenever the API answers 429 and recovers
+// gradually towards the configured rate as calls succeed.
+//
+// A nil *rateLimiter does not limit anything.
+type rateLimiter struct {
+	mu       sync.Mutex
+	baseRate float64 // configured tokens per second
+	rate     float64 // current tokens per second
+	burst    float64
+	tokens   float64
+	last     time.Time
+}
+
+// newRateLimiter returns nil, i.e. no limiting, when requestsPerMinute is not
+// positive.
+func newRateLimiter(requestsPerMinute, burst int) *rateLimiter {
+	if requestsPerMinute <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	rate := float64(requestsPerMinute) / 60
+	return &rateLimiter{
+		baseRate: rate,
+		rate:     rate,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.refill(now)
+	// Take the token right away, even if that leaves the bucket in debt, so
+	// that concurrent callers queue up behind each other instead of racing
+	// for the next refill.
+	l.tokens--
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if wait > 0 {
+		return sleepContext(ctx, wait)
+	}
+	return nil
+}
+
+// Throttled slows the limiter down after the API rejected a request with 429.
+func (l *rateLimiter) Throttled() {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill(time.Now())
+	l.rate /= 2
+	if min := l.baseRate * minRateFraction; l.rate < min {
+		l.rate = min
+	}
+	if l.tokens > 0 {
+		l.tokens = 0
+	}
+	log.Printf("[WARN] Sumo Logic API is throttling requests, slowing down to %.1f requests per minute", l.rate*60)
+}
+
+// Succeeded lets the limiter recover after an earlier slowdown.
+func (l *rateLimiter) Succeeded() {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.rate < l.baseRate {
+		l.refill(time.Now())
+		l.rate += l.baseRate * rateRecoveryFraction
+		if l.rate > l.baseRate {
+			l.rate = l.baseRate
+		}
+	}
+}
+
+func (l *rateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	l.last = now
+	l.tokens += elapsed * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
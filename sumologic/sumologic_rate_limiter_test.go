@@ -0,0 +1,94 @@
+package sumologic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterDisabled(t *testing.T) {
+	if l := newRateLimiter(0, 10); l != nil {
+		t.Fatalf("Expected a disabled limiter, got %+v", l)
+	}
+
+	var l *rateLimiter
+	start := time.Now()
+	for i := 0; i < 100; i++ {
+		l.Wait(context.Background())
+	}
+	l.Throttled()
+	l.Succeeded()
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("Expected a nil limiter not to wait, took %s", elapsed)
+	}
+}
+
+func TestRateLimiterBurst(t *testing.T) {
+	l := newRateLimiter(60, 5)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		l.Wait(context.Background())
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("Expected burst requests not to wait, took %s", elapsed)
+	}
+}
+
+func TestRateLimiterWaitsWhenEmpty(t *testing.T) {
+	// 1200 requests per minute = one token every 50ms.
+	l := newRateLimiter(1200, 1)
+
+	l.Wait(context.Background())
+	start := time.Now()
+	l.Wait(context.Background())
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("Expected to wait for a token to refill, took %s", elapsed)
+	}
+}
+
+func TestRateLimiterAdaptsToThrottling(t *testing.T) {
+	l := newRateLimiter(240, 10)
+	base := l.rate
+
+	l.Throttled()
+	if l.rate != base/2 {
+		t.Errorf("Expected the rate to halve, got %f (base %f)", l.rate, base)
+	}
+	if l.tokens > 0 {
+		t.Errorf("Expected the bucket to be drained, got %f tokens", l.tokens)
+	}
+
+	for i := 0; i < 10; i++ {
+		l.Throttled()
+	}
+	if min := base * minRateFraction; l.rate != min {
+		t.Errorf("Expected the rate to bottom out at %f, got %f", min, l.rate)
+	}
+
+	for i := 0; i < 100; i++ {
+		l.Succeeded()
+	}
+	if l.rate != base {
+		t.Errorf("Expected the rate to recover to %f, got %f", base, l.rate)
+	}
+}
+
+func TestClientsHaveIndependentLimiters(t *testing.T) {
+	throttled := newRetryTestClient(&sequenceHttpClient{
+		responses: []*http.Response{newTestResponse(429, "", nil)},
+	})
+	throttled.MaxRetries = 0
+	throttled.limiter = newRateLimiter(240, 10)
+
+	other := newTestClient(nil)
+	other.limiter = newRateLimiter(240, 10)
+
+	throttled.Get("v1/collectors", false)
+
+	if throttled.limiter.rate >= other.limiter.rate {
+		t.Errorf("Expected only the throttled client to slow down, got %f and %f",
+			throttled.limiter.rate, other.limiter.rate)
+	}
+}
@@ -0,0 +1,89 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type RemoteFileSource struct {
+	Source
+	RemoteHosts    []string `json:"remoteHosts"`
+	RemotePort     int      `json:"remotePort"`
+	RemoteUser     string   `json:"remoteUser"`
+	RemotePassword string   `json:"remotePassword,omitempty"`
+	KeyPath        string   `json:"keyPath,omitempty"`
+	KeyPassword    string   `json:"keyPassword,omitempty"`
+	RemotePaths    []string `json:"remotePaths"`
+	AuthMethod     string   `json:"authMethod"`
+	Denylist       []string `json:"denylist,omitempty"`
+}
+
+func (s *Client) CreateRemoteFileSource(remoteFileSource RemoteFileSource, collectorID int) (int, error) {
+
+	type RemoteFileSourceMessage struct {
+		Source RemoteFileSource `json:"source"`
+	}
+
+	request := RemoteFileSourceMessage{
+		Source: remoteFileSource,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources", collectorID)
+	body, err := s.Post(urlPath, request, false)
+
+	if err != nil {
+		return -1, err
+	}
+
+	var response RemoteFileSourceMessage
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return -1, err
+	}
+
+	return response.Source.ID, nil
+}
+
+func (s *Client) GetRemoteFileSource(collectorID, sourceID int) (*RemoteFileSource, error) {
+
+	body, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if body == nil {
+		return nil, nil
+	}
+
+	type Response struct {
+		Source RemoteFileSource `json:"source"`
+	}
+
+	var response Response
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Source.ETag = etag
+
+	return &response.Source, nil
+}
+
+func (s *Client) UpdateRemoteFileSource(source RemoteFileSource, collectorID int) error {
+
+	type RemoteFileSourceMessage struct {
+		Source RemoteFileSource `json:"source"`
+	}
+
+	request := RemoteFileSourceMessage{
+		Source: source,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, source.ID)
+	_, err := s.PutWithETag(urlPath, request, source.ETag, false)
+
+	return err
+}
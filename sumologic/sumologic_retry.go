@@ -0,0 +1,89 @@
+package sumologic
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const retryBaseWait = 1 * time.Second
+
+// shouldRetry decides whether a request is worth another attempt. Rate limited
+// requests are retried for every verb since the API rejected them before doing
+// any work; other transient failures are only retried for idempotent verbs.
+func shouldRetry(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// retryWait returns how long to wait before the next attempt. A Retry-After
+// header sent by the API takes precedence over the computed backoff; both are
+// capped at RetryMaxWait.
+func (s *Client) retryWait(attempt int, resp *http.Response) time.Duration {
+	maxWait := s.RetryMaxWait
+	if maxWait <= 0 {
+		maxWait = defaultRetryMaxWait
+	}
+
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > maxWait {
+				return maxWait
+			}
+			return wait
+		}
+	}
+
+	backoff := retryBaseWait << uint(attempt)
+	if backoff <= 0 || backoff > maxWait {
+		backoff = maxWait
+	}
+
+	// Equal jitter: wait at least half of the backoff so that retries still
+	// spread out, and randomize the rest to avoid synchronized clients.
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter understands both forms allowed by RFC 7231: a number of
+// seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,183 @@
+package sumologic
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type sequenceHttpClient struct {
+	responses []*http.Response
+	errors    []error
+	requests  []*http.Request
+}
+
+func (c *sequenceHttpClient) Do(req *http.Request) (*http.Response, error) {
+	i := len(c.requests)
+	c.requests = append(c.requests, req)
+	if i < len(c.errors) && c.errors[i] != nil {
+		return nil, c.errors[i]
+	}
+	return c.responses[i], nil
+}
+
+func newRetryTestClient(httpClient HttpClient) *Client {
+	client := newTestClient(nil)
+	client.httpClient = httpClient
+	client.MaxRetries = 3
+	client.RetryMaxWait = 10 * time.Millisecond
+	return client
+}
+
+func newTestResponse(statusCode int, body string, header http.Header) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:     http.StatusText(statusCode),
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
+
+func TestGetRetriesTransientErrors(t *testing.T) {
+	httpClient := &sequenceHttpClient{
+		responses: []*http.Response{
+			newTestResponse(503, "unavailable", nil),
+			newTestResponse(502, "bad gateway", nil),
+			newTestResponse(200, `{"ok":true}`, nil),
+		},
+	}
+	client := newRetryTestClient(httpClient)
+
+	data, _, err := client.Get("v1/collectors", false)
+	if err != nil {
+		t.Fatalf("Expected Get to succeed after retries, received: %s", err)
+	}
+	if string(data) != `{"ok":true}` {
+		t.Errorf("Expected body of the last response, got %s", data)
+	}
+	if len(httpClient.requests) != 3 {
+		t.Errorf("Expected 3 attempts, got %d", len(httpClient.requests))
+	}
+}
+
+func TestPostIsNotRetriedOnServerError(t *testing.T) {
+	httpClient := &sequenceHttpClient{
+		responses: []*http.Response{
+			newTestResponse(503, "unavailable", nil),
+			newTestResponse(200, `{}`, nil),
+		},
+	}
+	client := newRetryTestClient(httpClient)
+
+	_, err := client.Post("v1/collectors", map[string]string{}, false)
+	if err == nil {
+		t.Fatal("Expected Post to fail without retrying")
+	}
+	if len(httpClient.requests) != 1 {
+		t.Errorf("Expected a single attempt, got %d", len(httpClient.requests))
+	}
+}
+
+func TestPostIsRetriedWhenRateLimited(t *testing.T) {
+	httpClient := &sequenceHttpClient{
+		responses: []*http.Response{
+			newTestResponse(429, "slow down", http.Header{"Retry-After": []string{"0"}}),
+			newTestResponse(200, `{"id":"1"}`, nil),
+		},
+	}
+	client := newRetryTestClient(httpClient)
+
+	_, err := client.Post("v1/collectors", map[string]string{"name": "c"}, false)
+	if err != nil {
+		t.Fatalf("Expected Post to succeed after retry, received: %s", err)
+	}
+	if len(httpClient.requests) != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", len(httpClient.requests))
+	}
+	body, _ := ioutil.ReadAll(httpClient.requests[1].Body)
+	if string(body) != `{"name":"c"}` {
+		t.Errorf("Expected the retried request to resend the payload, got %s", body)
+	}
+}
+
+func TestDeleteRetriesNetworkErrors(t *testing.T) {
+	httpClient := &sequenceHttpClient{
+		responses: []*http.Response{nil, newTestResponse(200, "", nil)},
+		errors:    []error{errors.New("connection reset by peer")},
+	}
+	client := newRetryTestClient(httpClient)
+
+	if _, err := client.Delete("v1/collectors/1"); err != nil {
+		t.Fatalf("Expected Delete to succeed after retry, received: %s", err)
+	}
+	if len(httpClient.requests) != 2 {
+		t.Errorf("Expected 2 attempts, got %d", len(httpClient.requests))
+	}
+}
+
+func TestRetriesAreBoundedByMaxRetries(t *testing.T) {
+	httpClient := &sequenceHttpClient{
+		responses: []*http.Response{
+			newTestResponse(429, "", nil),
+			newTestResponse(429, "", nil),
+			newTestResponse(429, "", nil),
+			newTestResponse(429, "rate limited", nil),
+		},
+	}
+	client := newRetryTestClient(httpClient)
+
+	_, _, err := client.Get("v1/collectors", false)
+	if err == nil {
+		t.Fatal("Expected Get to fail once retries are exhausted")
+	}
+	if len(httpClient.requests) != client.MaxRetries+1 {
+		t.Errorf("Expected %d attempts, got %d", client.MaxRetries+1, len(httpClient.requests))
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	client := &Client{RetryMaxWait: 8 * time.Second}
+
+	for attempt := 0; attempt < 6; attempt++ {
+		wait := client.retryWait(attempt, nil)
+		backoff := retryBaseWait << uint(attempt)
+		if backoff > client.RetryMaxWait {
+			backoff = client.RetryMaxWait
+		}
+		if wait < backoff/2 || wait > backoff {
+			t.Errorf("attempt %d: expected wait within [%s, %s], got %s", attempt, backoff/2, backoff, wait)
+		}
+	}
+
+	resp := newTestResponse(429, "", http.Header{"Retry-After": []string{"3"}})
+	if wait := client.retryWait(0, resp); wait != 3*time.Second {
+		t.Errorf("Expected Retry-After to be honored, got %s", wait)
+	}
+
+	resp = newTestResponse(429, "", http.Header{"Retry-After": []string{"120"}})
+	if wait := client.retryWait(0, resp); wait != client.RetryMaxWait {
+		t.Errorf("Expected Retry-After to be capped at %s, got %s", client.RetryMaxWait, wait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if _, ok := parseRetryAfter(""); ok {
+		t.Error("Expected empty Retry-After to be ignored")
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("Expected malformed Retry-After to be ignored")
+	}
+	if wait, ok := parseRetryAfter("7"); !ok || wait != 7*time.Second {
+		t.Errorf("Expected 7s, got %s", wait)
+	}
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if wait, ok := parseRetryAfter(date); !ok || wait <= 59*time.Minute {
+		t.Errorf("Expected roughly an hour, got %s", wait)
+	}
+}
@@ -0,0 +1,86 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type ScriptSource struct {
+	Source
+	Commands       []string `json:"commands"`
+	File           string   `json:"file,omitempty"`
+	Script         string   `json:"script,omitempty"`
+	WorkingDir     string   `json:"workingDir,omitempty"`
+	Timeout        int      `json:"timeout"`
+	CronExpression string   `json:"cronExpression"`
+}
+
+func (s *Client) CreateScriptSource(scriptSource ScriptSource, collectorID int) (int, error) {
+
+	type ScriptSourceMessage struct {
+		Source ScriptSource `json:"source"`
+	}
+
+	request := ScriptSourceMessage{
+		Source: scriptSource,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources", collectorID)
+	body, err := s.Post(urlPath, request, false)
+
+	if err != nil {
+		return -1, err
+	}
+
+	var response ScriptSourceMessage
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return -1, err
+	}
+
+	return response.Source.ID, nil
+}
+
+func (s *Client) GetScriptSource(collectorID, sourceID int) (*ScriptSource, error) {
+
+	body, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if body == nil {
+		return nil, nil
+	}
+
+	type Response struct {
+		Source ScriptSource `json:"source"`
+	}
+
+	var response Response
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Source.ETag = etag
+
+	return &response.Source, nil
+}
+
+func (s *Client) UpdateScriptSource(source ScriptSource, collectorID int) error {
+
+	type ScriptSourceMessage struct {
+		Source ScriptSource `json:"source"`
+	}
+
+	request := ScriptSourceMessage{
+		Source: source,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, source.ID)
+	_, err := s.PutWithETag(urlPath, request, source.ETag, false)
+
+	return err
+}
@@ -0,0 +1,131 @@
+package sumologic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// The search job API keeps a job on the node that created it, so requests for
+// the job must send the cookies of the response that created it.
+
+type SearchJob struct {
+	Query    string `json:"query"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+	TimeZone string `json:"timeZone"`
+}
+
+type SearchJobStatus struct {
+	State           string   `json:"state"`
+	MessageCount    int      `json:"messageCount"`
+	RecordCount     int      `json:"recordCount"`
+	PendingErrors   []string `json:"pendingErrors"`
+	PendingWarnings []string `json:"pendingWarnings"`
+}
+
+// searchJobPollInterval is how long to wait between polls of the status of a
+// search job.
+var searchJobPollInterval = 2 * time.Second
+
+// searchJobDeleteTimeout is how long deleting a search job may take. The job
+// is deleted with a context of its own, so that it is also deleted when the
+// search timed out or was cancelled.
+const searchJobDeleteTimeout = 30 * time.Second
+
+// searchJobRecordsPageSize is the number of records requested at a time, the
+// maximum the API allows.
+const searchJobRecordsPageSize = 10000
+
+// RunSearchJob runs a search job to completion and returns its records, the
+// fields of each record by name. The job is deleted afterwards.
+func (s *Client) RunSearchJob(job SearchJob) ([]map[string]string, error) {
+	body, cookies, err := s.PostWithCookies("v1/search/jobs", job)
+	if err != nil {
+		return nil, err
+	}
+
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Search job id: %s", created.ID)
+
+	urlPath := fmt.Sprintf("v1/search/jobs/%s", created.ID)
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), searchJobDeleteTimeout)
+		defer cancel()
+		request := apiRequest{method: http.MethodDelete, urlPath: urlPath, cookies: cookies}
+		if _, _, err := s.WithContext(ctx).send(request); err != nil {
+			log.Printf("[WARN] Failed to delete search job %s: %s", created.ID, err)
+		}
+	}()
+
+	status, err := s.waitForSearchJob(urlPath, cookies)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]map[string]string, 0, status.RecordCount)
+	for offset := 0; offset < status.RecordCount; offset += searchJobRecordsPageSize {
+		body, _, err := s.GetWithCookies(fmt.Sprintf("%s/records?offset=%d&limit=%d",
+			urlPath, offset, searchJobRecordsPageSize), cookies)
+		if err != nil {
+			return nil, err
+		}
+
+		var page struct {
+			Records []struct {
+				Map map[string]string `json:"map"`
+			} `json:"records"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, err
+		}
+		for _, record := range page.Records {
+			records = append(records, record.Map)
+		}
+		if len(page.Records) == 0 {
+			break
+		}
+	}
+
+	return records, nil
+}
+
+func (s *Client) waitForSearchJob(urlPath string, cookies []*http.Cookie) (*SearchJobStatus, error) {
+	for {
+		body, _, err := s.GetWithCookies(urlPath, cookies)
+		if err != nil {
+			return nil, err
+		}
+		if body == nil {
+			return nil, fmt.Errorf("search job %s not found", urlPath)
+		}
+
+		var status SearchJobStatus
+		if err := json.Unmarshal(body, &status); err != nil {
+			return nil, err
+		}
+		if len(status.PendingErrors) > 0 {
+			return nil, fmt.Errorf("search job failed: %s", strings.Join(status.PendingErrors, ", "))
+		}
+
+		switch status.State {
+		case "DONE GATHERING RESULTS":
+			return &status, nil
+		case "CANCELLED", "FORCE PAUSED":
+			return nil, fmt.Errorf("search job was %s", strings.ToLower(status.State))
+		}
+
+		if err := sleepContext(s.Context(), searchJobPollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package sumologic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// cancelOnPollHttpClient cancels the context of the client when the status of
+// the search job is polled, like a timeout expiring during the search.
+type cancelOnPollHttpClient struct {
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnPollHttpClient) Do(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet {
+		c.cancel()
+	}
+	return http.DefaultClient.Do(req)
+}
+
+func TestRunSearchJobDeletesJobWhenCancelled(t *testing.T) {
+	api := newFakeSumoAPI(t)
+	client, err := NewClient("fakeaccessid", "fakeaccesskey", "", api.server.URL+"/api/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client.httpClient = &cancelOnPollHttpClient{cancel: cancel}
+	client.MaxRetries = 0
+
+	_, err = client.WithContext(ctx).RunSearchJob(SearchJob{
+		Query:    "_sourceCategory=prod error\n| timeslice 15m\n| count by _timeslice",
+		From:     "2026-01-01T00:00:00",
+		To:       "2026-01-01T01:00:00",
+		TimeZone: "UTC",
+	})
+	if err != context.Canceled {
+		t.Fatalf("Expected the search to be cancelled, got %v", err)
+	}
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	if len(api.jobs) > 0 {
+		t.Errorf("Expected the search job to be deleted, %d jobs are left", len(api.jobs))
+	}
+}
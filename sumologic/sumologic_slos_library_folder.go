@@ -0,0 +1,71 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ---------- ENDPOINTS ----------
+
+func (s *Client) CreateSlosLibraryFolder(slosLibraryFolder SlosLibraryFolder, parentID string) (string, error) {
+	data, err := s.Post(fmt.Sprintf("v1/slos?parentId=%s", parentID), slosLibraryFolder, false)
+	if err != nil {
+		return "", err
+	}
+
+	var createdSlosLibraryFolder SlosLibraryFolder
+	if err := json.Unmarshal(data, &createdSlosLibraryFolder); err != nil {
+		return "", err
+	}
+	return createdSlosLibraryFolder.ID, nil
+}
+
+func (s *Client) GetSlosLibraryFolder(id string) (*SlosLibraryFolder, error) {
+	data, _, err := s.Get(fmt.Sprintf("v1/slos/%s", id), false)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var slosLibraryFolder SlosLibraryFolder
+	if err := json.Unmarshal(data, &slosLibraryFolder); err != nil {
+		return nil, err
+	}
+	return &slosLibraryFolder, nil
+}
+
+// UpdateSlosLibraryFolder updates a folder, and moves it to its parent folder.
+func (s *Client) UpdateSlosLibraryFolder(slosLibraryFolder SlosLibraryFolder) error {
+	urlPath := fmt.Sprintf("v1/slos/%s?parentId=%s", slosLibraryFolder.ID, slosLibraryFolder.ParentID)
+	slosLibraryFolder.ID = ""
+
+	_, err := s.Put(urlPath, slosLibraryFolder, false)
+	return err
+}
+
+func (s *Client) DeleteSlosLibraryFolder(id string) error {
+	_, err := s.Delete(fmt.Sprintf("v1/slos/%s", id))
+	return err
+}
+
+// ---------- TYPES ----------
+type SlosLibraryFolder struct {
+	ID          string `json:"id,omitempty"`
+	Type        string `json:"type"`
+	ContentType string `json:"contentType"`
+	ParentID    string `json:"parentId"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CreatedBy   string `json:"createdBy"`
+	CreatedAt   string `json:"createdAt"`
+	ModifiedBy  string `json:"modifiedBy"`
+	ModifiedAt  string `json:"modifiedAt"`
+	IsLocked    bool   `json:"isLocked"`
+	IsMutable   bool   `json:"isMutable"`
+	IsSystem    bool   `json:"isSystem"`
+	Version     int    `json:"version"`
+}
+
+// ---------- END ----------
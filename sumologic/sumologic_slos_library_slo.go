@@ -0,0 +1,108 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ---------- ENDPOINTS ----------
+
+func (s *Client) CreateSlosLibrarySlo(slosLibrarySlo SlosLibrarySlo, parentID string) (string, error) {
+	data, err := s.Post(fmt.Sprintf("v1/slos?parentId=%s", parentID), slosLibrarySlo, false)
+	if err != nil {
+		return "", err
+	}
+
+	var createdSlosLibrarySlo SlosLibrarySlo
+	if err := json.Unmarshal(data, &createdSlosLibrarySlo); err != nil {
+		return "", err
+	}
+	return createdSlosLibrarySlo.ID, nil
+}
+
+func (s *Client) GetSlosLibrarySlo(id string) (*SlosLibrarySlo, error) {
+	data, _, err := s.Get(fmt.Sprintf("v1/slos/%s", id), false)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var slosLibrarySlo SlosLibrarySlo
+	if err := json.Unmarshal(data, &slosLibrarySlo); err != nil {
+		return nil, err
+	}
+	return &slosLibrarySlo, nil
+}
+
+// UpdateSlosLibrarySlo updates an SLO, and moves it to its parent folder.
+func (s *Client) UpdateSlosLibrarySlo(slosLibrarySlo SlosLibrarySlo) error {
+	urlPath := fmt.Sprintf("v1/slos/%s?parentId=%s", slosLibrarySlo.ID, slosLibrarySlo.ParentID)
+	slosLibrarySlo.ID = ""
+
+	_, err := s.Put(urlPath, slosLibrarySlo, false)
+	return err
+}
+
+func (s *Client) DeleteSlosLibrarySlo(id string) error {
+	_, err := s.Delete(fmt.Sprintf("v1/slos/%s", id))
+	return err
+}
+
+// ---------- TYPES ----------
+type SlosLibrarySlo struct {
+	ID          string        `json:"id,omitempty"`
+	Type        string        `json:"type"`
+	ContentType string        `json:"contentType"`
+	ParentID    string        `json:"parentId"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	CreatedBy   string        `json:"createdBy"`
+	CreatedAt   string        `json:"createdAt"`
+	ModifiedBy  string        `json:"modifiedBy"`
+	ModifiedAt  string        `json:"modifiedAt"`
+	IsLocked    bool          `json:"isLocked"`
+	IsMutable   bool          `json:"isMutable"`
+	IsSystem    bool          `json:"isSystem"`
+	Version     int           `json:"version"`
+	SignalType  string        `json:"signalType"`
+	Service     string        `json:"service,omitempty"`
+	Application string        `json:"application,omitempty"`
+	Compliance  SloCompliance `json:"compliance"`
+	Indicator   SloIndicator  `json:"indicator"`
+}
+
+type SloCompliance struct {
+	ComplianceType string  `json:"complianceType"`
+	Target         float64 `json:"target"`
+	TimeZone       string  `json:"timezone"`
+	// Size is the length of a rolling compliance period, e.g. 7d, or the
+	// calendar period, e.g. Week.
+	Size string `json:"size"`
+}
+
+type SloIndicator struct {
+	EvaluationType string          `json:"evaluationType"`
+	QueryType      string          `json:"queryType"`
+	Queries        []SloQueryGroup `json:"queries"`
+	Threshold      float64         `json:"threshold,omitempty"`
+	Op             string          `json:"op,omitempty"`
+	Aggregation    string          `json:"aggregation,omitempty"`
+	// Size is the length of the windows of window based evaluations.
+	Size string `json:"size,omitempty"`
+}
+
+type SloQueryGroup struct {
+	QueryGroupType string     `json:"queryGroupType"`
+	QueryGroup     []SloQuery `json:"queryGroup"`
+}
+
+type SloQuery struct {
+	RowID       string `json:"rowId"`
+	Query       string `json:"query"`
+	UseRowCount bool   `json:"useRowCount"`
+	Field       string `json:"field,omitempty"`
+}
+
+// ---------- END ----------
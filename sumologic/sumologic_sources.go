@@ -3,6 +3,7 @@ package sumologic
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -30,6 +31,9 @@ type Source struct {
 	Fields                     map[string]interface{} `json:"fields,omitempty"`
 	Url                        string                 `json:"url,omitempty"`
 	ContentType                string                 `json:"contentType,omitempty"`
+	// ETag is read from the response headers and sent back as If-Match on
+	// updates.
+	ETag string `json:"-"`
 }
 
 type DefaultDateFormat struct {
@@ -50,7 +54,8 @@ type SourceList struct {
 
 func resourceSumologicSource() *schema.Resource {
 	return &schema.Resource{
-		Delete: resourceSumologicSourceDelete,
+		Delete:        resourceSumologicSourceDelete,
+		CustomizeDiff: resourceSumologicSourceCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -120,27 +125,7 @@ func resourceSumologicSource() *schema.Resource {
 			"filters": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"filter_type": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"Exclude", "Include", "Hash", "Mask", "Forward"}, false),
-						},
-						"regexp": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"mask": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-					},
-				},
+				Elem:     sourceFilterResource(),
 			},
 			"cutoff_timestamp": {
 				Type:     schema.TypeInt,
@@ -171,6 +156,59 @@ func resourceSumologicSource() *schema.Resource {
 				Optional: true,
 				Default:  nil,
 			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// sourcePausedSchema is the schema of paused, for the sources that can be
+// paused. It is computed, so that sources paused by sumologic_source_pause
+// are only resumed if paused is set to false.
+func sourcePausedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true,
+	}
+}
+
+// sourceScanIntervalSchema is the schema of scan_interval, in milliseconds,
+// for the sources that poll.
+func sourceScanIntervalSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      300000,
+		ValidateFunc: validation.IntBetween(1000, 24*60*60*1000),
+	}
+}
+
+// sourceFilterResource is the schema of a filter, or processing rule, of a
+// source.
+func sourceFilterResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Exclude", "Include", "Hash", "Mask", "Forward"}, false),
+			},
+			"regexp": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFilterRegexp,
+			},
+			"mask": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -238,6 +276,7 @@ func resourceToSource(d *schema.ResourceData) Source {
 	source.CutoffRelativeTime = d.Get("cutoff_relative_time").(string)
 	source.Fields = d.Get("fields").(map[string]interface{})
 	source.ContentType = d.Get("content_type").(string)
+	source.ETag = d.Get("etag").(string)
 
 	return source
 }
@@ -265,6 +304,7 @@ func resourceSumologicSourceRead(d *schema.ResourceData, source Source) error {
 		return fmt.Errorf("error setting fields for resource %s: %s", d.Id(), err)
 	}
 	d.Set("content_type", source.ContentType)
+	d.Set("etag", source.ETag)
 	return nil
 }
 
@@ -328,6 +368,19 @@ func getFilters(d *schema.ResourceData) []Filter {
 	return filters
 }
 
+// getStringList returns the list of strings configured for key, skipping
+// empty elements.
+func getStringList(d *schema.ResourceData, key string) []string {
+	rawList := d.Get(key).([]interface{})
+	list := make([]string, 0, len(rawList))
+	for _, v := range rawList {
+		if v != nil {
+			list = append(list, v.(string))
+		}
+	}
+	return list
+}
+
 func (s *Client) DestroySource(sourceID int, collectorID int) error {
 
 	_, err := s.Delete(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID))
@@ -335,6 +388,102 @@ func (s *Client) DestroySource(sourceID int, collectorID int) error {
 	return err
 }
 
+// GetSourceDefinition returns a source of any type along with its JSON
+// definition as returned by the API, or nil if it does not exist.
+func (s *Client) GetSourceDefinition(collectorID int64, sourceID int) (*Source, json.RawMessage, error) {
+	data, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if data == nil {
+		return nil, nil, nil
+	}
+
+	var response struct {
+		Source json.RawMessage `json:"source"`
+	}
+	err = json.Unmarshal(data, &response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var source Source
+	err = json.Unmarshal(response.Source, &source)
+	if err != nil {
+		return nil, nil, err
+	}
+	source.ETag = etag
+
+	return &source, response.Source, nil
+}
+
+// SetSourcePaused pauses or resumes a source of any type that can be paused,
+// sending its other settings back unchanged. It reports whether the source
+// exists and whether it was paused before.
+func (s *Client) SetSourcePaused(collectorID int64, sourceID int, paused bool) (found bool, wasPaused bool, err error) {
+	source, definition, err := s.GetSourceDefinition(collectorID, sourceID)
+	if err != nil || source == nil {
+		return false, false, err
+	}
+
+	var settings map[string]interface{}
+	err = json.Unmarshal(definition, &settings)
+	if err != nil {
+		return true, false, err
+	}
+
+	wasPaused, _ = settings["paused"].(bool)
+	if wasPaused == paused {
+		return true, wasPaused, nil
+	}
+	settings["paused"] = paused
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID)
+	_, err = s.PutWithETag(urlPath, map[string]interface{}{"source": settings}, source.ETag, false)
+
+	return true, wasPaused, err
+}
+
+// ListSources returns all sources of a collector, requesting them a page at a
+// time.
+func (s *Client) ListSources(collectorID int64) ([]Source, error) {
+	var sources []Source
+	seen := map[int]bool{}
+	for offset := 0; ; offset += listPageSize {
+		params := url.Values{}
+		params.Set("limit", strconv.Itoa(listPageSize))
+		params.Set("offset", strconv.Itoa(offset))
+
+		data, _, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources?%s", collectorID, params.Encode()), false)
+		if err != nil {
+			return nil, err
+		}
+		if data == nil {
+			return nil, fmt.Errorf("collector with id '%d' does not exist", collectorID)
+		}
+
+		var response SourceList
+		err = json.Unmarshal(data, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, source := range response.Sources {
+			// Guard against the whole list being returned for every page.
+			if !seen[source.ID] {
+				seen[source.ID] = true
+				sources = append(sources, source)
+				added++
+			}
+		}
+		if len(response.Sources) < listPageSize || added == 0 {
+			return sources, nil
+		}
+	}
+}
+
 func (s *Client) GetSourceName(collectorID int64, sourceName string) (*Source, error) {
 
 	data, _, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources", collectorID), false)
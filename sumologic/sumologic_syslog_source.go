@@ -0,0 +1,82 @@
+package sumologic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type SyslogSource struct {
+	Source
+	Protocol string `json:"protocol"`
+	Port     int    `json:"port"`
+}
+
+func (s *Client) CreateSyslogSource(syslogSource SyslogSource, collectorID int) (int, error) {
+
+	type SyslogSourceMessage struct {
+		Source SyslogSource `json:"source"`
+	}
+
+	request := SyslogSourceMessage{
+		Source: syslogSource,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources", collectorID)
+	body, err := s.Post(urlPath, request, false)
+
+	if err != nil {
+		return -1, err
+	}
+
+	var response SyslogSourceMessage
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return -1, err
+	}
+
+	return response.Source.ID, nil
+}
+
+func (s *Client) GetSyslogSource(collectorID, sourceID int) (*SyslogSource, error) {
+
+	body, etag, err := s.Get(fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, sourceID), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if body == nil {
+		return nil, nil
+	}
+
+	type Response struct {
+		Source SyslogSource `json:"source"`
+	}
+
+	var response Response
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Source.ETag = etag
+
+	return &response.Source, nil
+}
+
+func (s *Client) UpdateSyslogSource(source SyslogSource, collectorID int) error {
+
+	type SyslogSourceMessage struct {
+		Source SyslogSource `json:"source"`
+	}
+
+	request := SyslogSourceMessage{
+		Source: source,
+	}
+
+	urlPath := fmt.Sprintf("v1/collectors/%d/sources/%d", collectorID, source.ID)
+	_, err := s.PutWithETag(urlPath, request, source.ETag, false)
+
+	return err
+}
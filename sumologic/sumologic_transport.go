@@ -0,0 +1,86 @@
+package sumologic
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// transportConfig holds the provider arguments that shape how the provider
+// connects to the API.
+type transportConfig struct {
+	ProxyURL           string
+	CABundleFile       string
+	InsecureSkipVerify bool
+	ClientCertFile     string
+	ClientKeyFile      string
+	Timeout            time.Duration
+	DebugHTTP          bool
+}
+
+// newHTTPClient builds the *http.Client used for every API call, including
+// the endpoint discovery probe.
+func newHTTPClient(config transportConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid http_proxy %s: %s", config.ProxyURL, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Transport: newLoggingTransport(transport, config.DebugHTTP),
+		Timeout:   config.Timeout,
+	}, nil
+}
+
+func newTLSConfig(config transportConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CABundleFile != "" {
+		pem, err := ioutil.ReadFile(config.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca_bundle_file: %s", err)
+		}
+		// Keep trusting the system roots so that only the intercepting proxy's
+		// certificate has to be added to the bundle.
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM certificates found in ca_bundle_file %s", config.CABundleFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (config.ClientCertFile == "") != (config.ClientKeyFile == "") {
+		return nil, errors.New("client_cert_file and client_key_file must be set together")
+	}
+	if config.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
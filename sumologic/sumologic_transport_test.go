@@ -0,0 +1,99 @@
+package sumologic
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeServerCertificate(t *testing.T, server *httptest.Server) string {
+	dir, err := ioutil.TempDir("", "sumologic-transport")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "ca.pem")
+	block := &pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}
+	if err := ioutil.WriteFile(path, pem.EncodeToMemory(block), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestHTTPClientTrustsCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	untrusted, err := newHTTPClient(transportConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := untrusted.Get(server.URL); err == nil {
+		t.Fatal("Expected the self-signed certificate to be rejected without a CA bundle")
+	}
+
+	client, err := newHTTPClient(transportConfig{CABundleFile: writeServerCertificate(t, server)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Expected the CA bundle to be trusted, received: %s", err)
+	}
+	resp.Body.Close()
+}
+
+func TestHTTPClientInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client, err := newHTTPClient(transportConfig{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Expected certificate verification to be skipped, received: %s", err)
+	}
+	resp.Body.Close()
+}
+
+func TestHTTPClientProxyAndTimeout(t *testing.T) {
+	client, err := newHTTPClient(transportConfig{
+		ProxyURL: "http://proxy.corp.example:3128",
+		Timeout:  15 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Timeout != 15*time.Second {
+		t.Errorf("Expected a 15s timeout, got %s", client.Timeout)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.sumologic.com/api/v1/collectors", nil)
+	proxy, err := client.Transport.(*http.Transport).Proxy(req)
+	if err != nil || proxy == nil || proxy.Host != "proxy.corp.example:3128" {
+		t.Errorf("Expected requests to go through the proxy, got %v (%v)", proxy, err)
+	}
+}
+
+func TestHTTPClientConfigErrors(t *testing.T) {
+	configs := map[string]transportConfig{
+		"missing bundle":   {CABundleFile: "/does/not/exist.pem"},
+		"cert without key": {ClientCertFile: "client.pem"},
+		"key without cert": {ClientKeyFile: "client.key"},
+		"missing keypair":  {ClientCertFile: "/does/not/exist.pem", ClientKeyFile: "/does/not/exist.key"},
+		"invalid proxy":    {ProxyURL: "http://[::1"},
+	}
+	for name, config := range configs {
+		if _, err := newHTTPClient(config); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}